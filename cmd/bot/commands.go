@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/flor3z/discord-bot/internal/bot"
+	"github.com/flor3z/discord-bot/internal/config"
+)
+
+// commandsUsage describes the commands subcommand
+const commandsUsage = `Usage: bot commands <sync|list|purge> [-guild ID]
+
+  sync   register the bot's slash commands, replacing stale ones
+  list   show the registered slash commands
+  purge  remove every registered slash command
+
+Commands are managed globally unless -guild is given (default: DEV_GUILD_ID).
+`
+
+// runCommands manages slash command registration without starting the bot
+func runCommands(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("commands", flag.ContinueOnError)
+	flags.Usage = func() { fmt.Fprint(flags.Output(), commandsUsage) }
+	guildID := flags.String("guild", cfg.DevGuildID, "guild ID to manage instead of global commands")
+
+	if len(args) == 0 {
+		flags.Usage()
+		return fmt.Errorf("missing action")
+	}
+	action := args[0]
+	if action != "sync" && action != "list" && action != "purge" {
+		flags.Usage()
+		return fmt.Errorf("unknown action %q", action)
+	}
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	b, err := bot.New(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create bot: %w", err)
+	}
+	defer b.Stop()
+
+	scope := "global"
+	if *guildID != "" {
+		scope = "guild " + *guildID
+	}
+
+	switch action {
+	case "sync":
+		diff, err := b.SyncCommands(*guildID)
+		if err != nil {
+			return err
+		}
+		if !diff.Changed() {
+			fmt.Printf("Commands (%s) are up to date\n", scope)
+			return nil
+		}
+		fmt.Printf("Synced commands (%s): %s\n", scope, diff)
+	case "list":
+		commands, err := b.ListCommands(*guildID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d commands (%s)\n", len(commands), scope)
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		for _, cmd := range commands {
+			fmt.Fprintf(w, "%s\t/%s\t%s\n", cmd.ID, cmd.Name, cmd.Description)
+		}
+		w.Flush()
+	case "purge":
+		n, err := b.PurgeCommands(*guildID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Removed %d commands (%s)\n", n, scope)
+	}
+
+	return nil
+}
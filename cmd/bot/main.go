@@ -7,8 +7,12 @@ import (
 	"os/signal"
 	"syscall"
 
+	// Embedded timezone data for per-guild report schedules on minimal hosts
+	_ "time/tzdata"
+
 	"github.com/flor3z/discord-bot/internal/bot"
 	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/trace"
 )
 
 func main() {
@@ -22,6 +26,15 @@ func main() {
 	// Set up logging
 	setupLogging(cfg.LogLevel)
 
+	// Manage slash commands without running the bot
+	if len(os.Args) > 1 && os.Args[1] == "commands" {
+		if err := runCommands(cfg, os.Args[2:]); err != nil {
+			slog.Error("Command management failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	slog.Info("Starting LoL Match Tracker Bot")
 
 	// Create context that cancels on interrupt
@@ -77,5 +90,6 @@ func setupLogging(level string) {
 	handler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
 		Level: logLevel,
 	})
-	slog.SetDefault(slog.New(handler))
+	// Tag records logged with a traced context with its correlation ID
+	slog.SetDefault(slog.New(trace.NewHandler(handler)))
 }
@@ -0,0 +1,98 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+const (
+	// autocompleteTimeout bounds a player search; Discord waits 3 seconds for suggestions
+	autocompleteTimeout = 2 * time.Second
+
+	// maxChoiceLength is the longest name or value Discord accepts for a choice
+	maxChoiceLength = 100
+
+	// maxChoices is how many suggestions Discord shows
+	maxChoices = 25
+)
+
+// handleAutocomplete suggests players for a focused player option, found by
+// the search of the chosen game's tracker
+func (b *Bot) handleAutocomplete(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	// Options of a subcommand are nested under it
+	if len(options) == 1 && options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		options = options[0].Options
+	}
+
+	var gameType, query string
+	var focused bool
+	for _, opt := range options {
+		switch opt.Name {
+		case "게임":
+			gameType = opt.StringValue()
+		case "플레이어":
+			query, focused = strings.TrimSpace(opt.StringValue()), opt.Focused
+		}
+	}
+
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+	if focused && gameType != "" && query != "" {
+		choices = append(choices, b.searchPlayers(ctx, game.GameType(gameType), query)...)
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	})
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to send suggestions", "error", err)
+	}
+}
+
+// searchPlayers returns suggestions for a query from a game's tracker, or
+// none if the game can't search players
+func (b *Bot) searchPlayers(ctx context.Context, gameType game.GameType, query string) []*discordgo.ApplicationCommandOptionChoice {
+	tracker, err := b.registry.Get(gameType)
+	if err != nil {
+		return nil
+	}
+	searchable, ok := tracker.(game.Searchable)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, autocompleteTimeout)
+	defer cancel()
+
+	results, err := searchable.SearchPlayers(ctx, query, maxChoices)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to search players", "game", gameType, "query", query, "error", err)
+		return nil
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, result := range results {
+		if len(choices) == maxChoices {
+			break
+		}
+		// A longer value could not be sent back; the name is only shown
+		if len([]rune(result.Input)) > maxChoiceLength {
+			continue
+		}
+		name := result.Name
+		if name == "" {
+			name = result.Input
+		}
+		if runes := []rune(name); len(runes) > maxChoiceLength {
+			name = string(runes[:maxChoiceLength-1]) + "…"
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: result.Input})
+	}
+	return choices
+}
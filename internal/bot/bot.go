@@ -4,14 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"runtime/debug"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/flor3z/discord-bot/internal/config"
 	"github.com/flor3z/discord-bot/internal/game"
-	"github.com/flor3z/discord-bot/internal/games/lol"
+	"github.com/flor3z/discord-bot/internal/games/custom"
 	"github.com/flor3z/discord-bot/internal/games/maplestory"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/notify"
 	"github.com/flor3z/discord-bot/internal/poller"
 	"github.com/flor3z/discord-bot/internal/storage"
+	"github.com/flor3z/discord-bot/internal/trace"
+
+	// Game packages register their tracker factories in init()
+	_ "github.com/flor3z/discord-bot/internal/games/lol"
+	_ "github.com/flor3z/discord-bot/internal/games/steam"
+	_ "github.com/flor3z/discord-bot/internal/games/tft"
+	_ "github.com/flor3z/discord-bot/internal/games/valorant"
 )
 
 // Bot represents the Discord bot instance
@@ -21,8 +32,28 @@ type Bot struct {
 	repo     *storage.Repository
 	registry *game.Registry
 	poller   *poller.Poller
-	commands []*discordgo.ApplicationCommand
 	handlers map[string]CommandHandler
+
+	// components maps a custom ID prefix to its message component handler
+	components map[string]ComponentHandler
+
+	// signer signs the custom IDs of notification buttons
+	signer *customIDSigner
+
+	// maplestory is set when the MapleStory tracker is enabled
+	maplestory *maplestory.Tracker
+
+	// pinnedBoards tracks what pinned leaderboard messages currently show
+	pinnedBoards pinnedCache
+
+	// challenges holds the /연동 ownership challenges users are working on
+	challenges challengeStore
+
+	// telegram is set when a Telegram bot token is configured
+	telegram *notify.TelegramSink
+
+	// telegramClaims holds the codes guilds must post to route to a Telegram chat
+	telegramClaims telegramClaimStore
 }
 
 // New creates a new Bot instance
@@ -36,6 +67,11 @@ func New(cfg *config.Config) (*Bot, error) {
 	// Set intents
 	session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages
 
+	// Catch catalog mistakes before any message is rendered
+	if err := i18n.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Initialize storage
 	repo, err := storage.NewRepository(cfg.DatabasePath)
 	if err != nil {
@@ -45,22 +81,40 @@ func New(cfg *config.Config) (*Bot, error) {
 	// Initialize game registry and register trackers
 	registry := game.NewRegistry()
 
-	// Register League of Legends tracker
-	lolTracker := lol.NewTracker(cfg.RiotAPIKey)
-	registry.Register(lolTracker)
+	registry.EnableTrackers(cfg)
+	if cfg.CustomTrackersFile != "" {
+		trackers, err := custom.Register(registry, cfg.CustomTrackersFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load custom trackers: %w", err)
+		}
+		slog.Info("Custom trackers loaded", "file", cfg.CustomTrackersFile, "count", len(trackers))
+	}
+	for _, g := range registry.List() {
+		slog.Info("Tracker enabled", "game", g.Type)
+	}
+	for _, g := range registry.ListDisabled() {
+		slog.Warn("Tracker disabled", "game", g.Type, "reason", g.Reason)
+	}
+	if len(registry.List()) == 0 {
+		slog.Warn("No trackers enabled; check API key configuration")
+	}
 
-	// Register MapleStory tracker (only if API key is configured)
-	if cfg.NexonAPIKey != "" {
-		maplestoryTracker := maplestory.NewTracker(cfg.NexonAPIKey)
-		registry.Register(maplestoryTracker)
-		slog.Info("MapleStory tracker registered")
+	// Keep a typed reference for MapleStory-only commands
+	var maplestoryTracker *maplestory.Tracker
+	if tracker, err := registry.Get(game.GameTypeMaplestory); err == nil {
+		maplestoryTracker, _ = tracker.(*maplestory.Tracker)
 	}
 
 	b := &Bot{
-		config:   cfg,
-		session:  session,
-		repo:     repo,
-		registry: registry,
+		config:     cfg,
+		session:    session,
+		repo:       repo,
+		registry:   registry,
+		signer:     newCustomIDSigner(cfg.ComponentSecret),
+		maplestory: maplestoryTracker,
+	}
+	if cfg.TelegramBotToken != "" {
+		b.telegram = notify.NewTelegramSink(cfg.TelegramBotToken)
 	}
 
 	// Register command handlers
@@ -78,15 +132,41 @@ func (b *Bot) Start(ctx context.Context) error {
 
 	slog.Info("Connected to Discord", "user", b.session.State.User.Username)
 
-	// Register slash commands
-	if err := b.registerCommands(); err != nil {
-		return fmt.Errorf("failed to register commands: %w", err)
+	// Sync slash commands; a dev guild gets them instantly instead of globally
+	if b.config.DevGuildID != "" {
+		slog.Info("Syncing commands to dev guild", "guildID", b.config.DevGuildID)
+	}
+	diff, err := b.SyncCommands(b.config.DevGuildID)
+	if err != nil {
+		return fmt.Errorf("failed to sync commands: %w", err)
 	}
+	slog.Info("Slash commands synced", "changed", diff.Changed(), "diff", diff.String())
 
 	// Start the match poller
-	b.poller = poller.New(b.repo, b.registry, b.session, b.config.PollingIntervalSeconds)
+	sinks := []notify.Sink{
+		notify.NewChannelSink(b.session),
+		notify.NewDMSink(b.session),
+		notify.NewDiscordWebhookSink(),
+		notify.NewWebhookSink(),
+		notify.NewSlackSink(),
+	}
+	if b.telegram != nil {
+		sinks = append(sinks, b.telegram)
+	}
+	notifier := notify.NewDispatcher(sinks...)
+	b.poller = poller.New(b.repo, b.registry, notifier, b.config.PollingIntervalSeconds)
+	b.poller.OnPollComplete(b.refreshPinnedLeaderboards)
+	b.poller.SetComponentBuilder(b.notificationComponents)
 	go b.poller.Start(ctx)
 
+	// Send weekly and monthly recaps
+	go b.runReportLoop(ctx)
+
+	// Record daily MapleStory snapshots for growth history
+	if b.maplestory != nil {
+		go b.runSnapshotLoop(ctx)
+	}
+
 	return nil
 }
 
@@ -97,9 +177,6 @@ func (b *Bot) Stop() error {
 		b.poller.Stop()
 	}
 
-	// Remove registered commands (optional - comment out to keep commands)
-	// b.removeCommands()
-
 	// Close storage
 	if b.repo != nil {
 		b.repo.Close()
@@ -115,24 +192,56 @@ func (b *Bot) Stop() error {
 
 // registerHandlers sets up Discord event handlers
 func (b *Bot) registerHandlers() {
+	b.components = b.getComponents()
 	b.session.AddHandler(b.handleInteraction)
 	b.session.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
 		slog.Info("Bot is ready", "guilds", len(r.Guilds))
 	})
 }
 
-// handleInteraction processes slash command interactions
+// handleInteraction processes slash command, autocomplete and message component interactions
+// Each interaction gets a correlation ID for its logs and API calls, and a
+// panicking handler is answered with an error carrying that ID as a reference code
 func (b *Bot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx, id := trace.Start(context.Background())
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "Interaction handler panicked", "panic", r, "stack", string(debug.Stack()))
+			replyInternalError(s, i, b.locale(i), id)
+		}
+	}()
+
+	if i.Type == discordgo.InteractionMessageComponent {
+		b.handleComponent(ctx, s, i)
+		return
+	}
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		b.handleAutocomplete(ctx, s, i)
+		return
+	}
 	if i.Type != discordgo.InteractionApplicationCommand {
 		return
 	}
 
 	data := i.ApplicationCommandData()
-	slog.Debug("Received command", "command", data.Name, "guild", i.GuildID)
+	slog.DebugContext(ctx, "Received command", "command", data.Name, "guild", i.GuildID)
 
 	if handler, ok := b.handlers[data.Name]; ok {
-		handler(s, i)
+		handler(ctx, s, i)
+	} else {
+		slog.WarnContext(ctx, "Unknown command", "command", data.Name)
+	}
+}
+
+// handleComponent routes message component interactions by custom ID prefix
+func (b *Bot) handleComponent(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+	prefix, args, _ := strings.Cut(customID, ":")
+	slog.DebugContext(ctx, "Received component", "customID", customID, "guild", i.GuildID)
+
+	if handler, ok := b.components[prefix]; ok {
+		handler(ctx, s, i, args)
 	} else {
-		slog.Warn("Unknown command", "command", data.Name)
+		slog.WarnContext(ctx, "Unknown component", "customID", customID)
 	}
 }
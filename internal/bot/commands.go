@@ -2,18 +2,27 @@ package bot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
 	"github.com/flor3z/discord-bot/internal/storage"
 )
 
 // CommandHandler is a function that handles a slash command
-type CommandHandler func(s *discordgo.Session, i *discordgo.InteractionCreate)
+// ctx carries the interaction's correlation ID
+type CommandHandler func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate)
+
+// ComponentHandler is a function that handles a message component interaction
+// args is the part of the custom ID after the handler prefix
+type ComponentHandler func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, args string)
 
 // Command represents a slash command with its definition and handler
 type Command struct {
@@ -21,77 +30,101 @@ type Command struct {
 	Handler    CommandHandler
 }
 
+// inGuilds allows a command in servers only, the default
+func inGuilds() *[]discordgo.InteractionContextType {
+	return &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild}
+}
+
+// inGuildsAndDMs also allows a command in DMs with the bot, where it acts
+// on the invoking user's personal subscriptions
+func inGuildsAndDMs() *[]discordgo.InteractionContextType {
+	return &[]discordgo.InteractionContextType{discordgo.InteractionContextGuild, discordgo.InteractionContextBotDM}
+}
+
 // buildGameChoices creates the game selection choices for slash commands
 func (b *Bot) buildGameChoices() []*discordgo.ApplicationCommandOptionChoice {
-	games := b.registry.List()
-	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(games))
-	for i, g := range games {
+	return gameChoices(b.registry.GetAll())
+}
+
+// gameChoices creates the game selection choices for a set of trackers
+func gameChoices(trackers []game.Tracker) []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(trackers))
+	for i, tracker := range trackers {
 		choices[i] = &discordgo.ApplicationCommandOptionChoice{
-			Name:  g.Name,
-			Value: string(g.Type),
+			Name:  tracker.Name(),
+			Value: string(tracker.Type()),
 		}
 	}
 	return choices
 }
 
+// playerOptions are the options of commands that take a game and a player
+type playerOptions struct {
+	Game   string `option:"게임"`
+	Player string `option:"플레이어"`
+}
+
+// playerCommandOptions builds the game and player options shared by several commands
+func (b *Bot) playerCommandOptions(gameDescription string) []*discordgo.ApplicationCommandOption {
+	return b.playerCommandOptionsFor(b.registry.GetAll(), gameDescription)
+}
+
+// playerCommandOptionsFor builds the game and player options for a set of
+// trackers; the player is autocompleted if any game can search players
+func (b *Bot) playerCommandOptionsFor(trackers []game.Tracker, gameDescription string) []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "게임",
+			Description: gameDescription,
+			Required:    true,
+			Choices:     gameChoices(trackers),
+		},
+		{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         "플레이어",
+			Description:  "플레이어 ID (예: Faker#KR1)",
+			Required:     true,
+			Autocomplete: len(game.Supporting[game.Searchable](b.registry)) > 0,
+		},
+	}
+}
+
 // getCommands returns all command definitions with their handlers
 func (b *Bot) getCommands() []Command {
-	return []Command{
-		{
+	commands := []Command{
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
 				Name:        "등록",
 				Description: "플레이어를 등록하여 게임 활동을 추적합니다",
-				Options: []*discordgo.ApplicationCommandOption{
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "게임",
-						Description: "추적할 게임 (예: lol)",
-						Required:    true,
-						Choices:     b.buildGameChoices(),
-					},
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "플레이어",
-						Description: "플레이어 ID (예: Faker#KR1)",
-						Required:    true,
-					},
-				},
+				Contexts:    inGuildsAndDMs(),
+				Options:     b.playerCommandOptions("추적할 게임 (예: lol)"),
 			},
-			Handler: b.handleRegister,
-		},
-		{
+			Middleware: []Middleware{cooldown(5 * time.Second), deferReply(false)},
+			Run:        b.handleRegister,
+		}),
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
 				Name:        "해제",
 				Description: "플레이어의 게임 활동 추적을 중지합니다",
-				Options: []*discordgo.ApplicationCommandOption{
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "게임",
-						Description: "게임 (예: lol)",
-						Required:    true,
-						Choices:     b.buildGameChoices(),
-					},
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "플레이어",
-						Description: "플레이어 ID (예: Faker#KR1)",
-						Required:    true,
-					},
-				},
+				Contexts:    inGuildsAndDMs(),
+				Options:     b.playerCommandOptions("게임 (예: lol)"),
 			},
-			Handler: b.handleUnregister,
-		},
-		{
+			Run: b.handleUnregister,
+		}),
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
 				Name:        "목록",
 				Description: "이 서버에 등록된 모든 플레이어 목록",
+				Contexts:    inGuildsAndDMs(),
 			},
-			Handler: b.handleList,
-		},
-		{
+			Run: b.handleList,
+		}),
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
-				Name:        "채널설정",
-				Description: "게임 알림을 받을 채널 설정",
+				Name:                     "채널설정",
+				Description:              "게임 알림을 받을 채널 설정",
+				DefaultMemberPermissions: &manageGuildPermission,
 				Options: []*discordgo.ApplicationCommandOption{
 					{
 						Type:        discordgo.ApplicationCommandOptionChannel,
@@ -104,214 +137,230 @@ func (b *Bot) getCommands() []Command {
 					},
 				},
 			},
-			Handler: b.handleSetChannel,
-		},
-		{
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission)},
+			Run:        b.handleSetChannel,
+		}),
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
 				Name:        "게임목록",
 				Description: "추적 가능한 게임 목록",
+				Contexts:    inGuildsAndDMs(),
 			},
-			Handler: b.handleGames,
-		},
-		{
+			Run: b.handleGames,
+		}),
+		b.command(Spec{
 			Definition: &discordgo.ApplicationCommand{
 				Name:        "최근",
 				Description: "플레이어의 가장 최근 상태 정보를 조회합니다",
-				Options: []*discordgo.ApplicationCommandOption{
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "게임",
-						Description: "조회할 게임 (예: lol)",
-						Required:    true,
-						Choices:     b.buildGameChoices(),
-					},
-					{
-						Type:        discordgo.ApplicationCommandOptionString,
-						Name:        "플레이어",
-						Description: "플레이어 ID (예: Faker#KR1)",
-						Required:    true,
-					},
-				},
+				Contexts:    inGuildsAndDMs(),
+				Options:     b.playerCommandOptions("조회할 게임 (예: lol)"),
 			},
-			Handler: b.handleRecent,
-		},
+			Middleware: []Middleware{cooldown(3 * time.Second), deferReply(false)},
+			Run:        b.handleRecent,
+		}),
 	}
-}
 
-// registerCommands registers all slash commands with Discord
-func (b *Bot) registerCommands() error {
-	slog.Info("Registering slash commands")
-
-	commands := b.getCommands()
-	registeredCommands := make([]*discordgo.ApplicationCommand, 0, len(commands))
-	b.handlers = make(map[string]CommandHandler)
-
-	for _, cmd := range commands {
-		registered, err := b.session.ApplicationCommandCreate(
-			b.session.State.User.ID,
-			"", // Empty string = global command
-			cmd.Definition,
-		)
-		if err != nil {
-			return fmt.Errorf("failed to register command %s: %w", cmd.Definition.Name, err)
-		}
-		registeredCommands = append(registeredCommands, registered)
-		b.handlers[cmd.Definition.Name] = cmd.Handler
-		slog.Debug("Registered command", "name", cmd.Definition.Name)
+	commands = append(commands, b.routeCommands()...)
+	commands = append(commands, b.reportCommands()...)
+	commands = append(commands, b.leaderboardCommands()...)
+	commands = append(commands, b.languageCommands()...)
+	commands = append(commands, b.quietHoursCommands()...)
+	commands = append(commands, b.mentionCommands()...)
+	commands = append(commands, b.verifyCommands()...)
+	commands = append(commands, b.profileCommands()...)
+	commands = append(commands, b.lookupCommands()...)
+
+	if b.maplestory != nil {
+		commands = append(commands, b.maplestoryCommands()...)
 	}
 
-	b.commands = registeredCommands
-	slog.Info("Slash commands registered", "count", len(registeredCommands))
-	return nil
+	return commands
 }
 
-// removeCommands removes all registered slash commands
-func (b *Bot) removeCommands() {
-	for _, cmd := range b.commands {
-		err := b.session.ApplicationCommandDelete(b.session.State.User.ID, "", cmd.ID)
-		if err != nil {
-			slog.Error("Failed to remove command", "name", cmd.Name, "error", err)
-		}
+// getComponents returns all message component handlers keyed by custom ID prefix
+func (b *Bot) getComponents() map[string]ComponentHandler {
+	components := make(map[string]ComponentHandler)
+	components[leaderboardPagePrefix] = b.handleLeaderboardPage
+	components[notificationButtonPrefix] = b.handleNotificationButton
+
+	if b.maplestory != nil {
+		components[characterPagePrefix] = b.handleCharacterPage
 	}
+
+	return components
 }
 
 // handleRegister handles the /register command
-func (b *Bot) handleRegister(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	gameType := options[0].StringValue()
-	playerID := options[1].StringValue()
-
-	// Respond immediately to avoid timeout
-	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
-	})
-
-	// Get the tracker for this game
-	tracker, err := b.registry.Get(game.GameType(gameType))
-	if err != nil {
-		b.editResponse(s, i, fmt.Sprintf("알 수 없는 게임: `%s`. `/게임목록` 명령어로 지원되는 게임을 확인하세요.", gameType))
-		return
+func (b *Bot) handleRegister(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
 	}
+	l := c.Locale
 
-	// Validate player ID format
-	if err := tracker.ValidatePlayerID(playerID); err != nil {
-		b.editResponse(s, i, fmt.Sprintf("잘못된 플레이어 ID 형식: %s", err.Error()))
-		return
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
 	}
+	name := gameName(l, tracker.Type(), tracker.Name())
 
 	// Look up player from game API
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
 	defer cancel()
 
-	playerInfo, err := tracker.ResolvePlayer(ctx, playerID)
+	playerInfo, err := tracker.ResolvePlayer(ctx, opts.Player)
 	if err != nil {
-		slog.Error("Failed to look up player", "playerID", playerID, "error", err)
-		b.editResponse(s, i, fmt.Sprintf("플레이어 `%s`를 찾을 수 없습니다. ID를 확인하고 다시 시도해주세요.", playerID))
-		return
+		slog.ErrorContext(c.Context(), "Failed to look up player", "playerID", opts.Player, "error", err)
+		return i18n.Errorf("register.not_found", opts.Player)
 	}
 
-	// Get initial state
-	lastMatchID, err := tracker.GetCurrentState(ctx, playerInfo.ID)
+	summoner, created, err := b.ensureSummoner(ctx, tracker, playerInfo)
 	if err != nil {
-		slog.Warn("Failed to get initial match history", "playerID", playerInfo.ID, "error", err)
-		// Continue without last match ID - will be set on first poll
+		return err
 	}
 
-	// Store summoner
-	summoner := &storage.Summoner{
-		PUUID:       playerInfo.ID,
-		RiotID:      playerInfo.DisplayName,
-		GameType:    string(playerInfo.GameType),
-		Region:      "KR", // Default to KR for now
-		LastMatchID: lastMatchID,
+	// Subscribe this guild, or the invoking user in DMs
+	targetType, targetID := c.Target()
+	sub := &storage.Subscription{
+		SummonerID:   summoner.ID,
+		TargetType:   targetType,
+		TargetID:     targetID,
+		RegisteredBy: c.UserID(),
 	}
 
-	if err := b.repo.CreateSummoner(summoner); err != nil {
-		// Check if already exists
+	if err := b.repo.CreateSubscription(sub); err != nil {
 		if strings.Contains(err.Error(), "UNIQUE constraint") {
-			// Try to get existing summoner and add subscription
-			existing, _ := b.repo.GetSummonerByPUUIDAndGame(playerInfo.ID, string(playerInfo.GameType))
-			if existing != nil {
-				summoner = existing
-			} else {
-				b.editResponse(s, i, fmt.Sprintf("플레이어 `%s`는 이미 %s에 등록되어 있습니다.", summoner.RiotID, tracker.Name()))
-				return
+			if targetType == storage.TargetUser {
+				return i18n.Errorf("register.already_following", summoner.RiotID, name)
 			}
-		} else {
-			slog.Error("Failed to save summoner", "error", err)
-			b.editResponse(s, i, "플레이어 등록에 실패했습니다. 다시 시도해주세요.")
-			return
+			return i18n.Errorf("register.already_tracking", summoner.RiotID, name)
 		}
+		slog.ErrorContext(c.Context(), "Failed to create subscription", "error", err)
+		return i18n.Errorf("register.subscription_failed")
 	}
 
-	// Create subscription for this guild
-	sub := &storage.Subscription{
-		SummonerID:   summoner.ID,
-		GuildID:      i.GuildID,
-		RegisteredBy: i.Member.User.ID,
+	// Backfill daily history so growth charts are available right away
+	// An existing character already has its history filled
+	if created && playerInfo.GameType == game.GameTypeMaplestory && b.maplestory != nil {
+		go b.backfillSnapshots(summoner)
 	}
 
-	if err := b.repo.CreateSubscription(sub); err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint") {
-			b.editResponse(s, i, fmt.Sprintf("플레이어 `%s`는 이미 이 서버에서 %s 추적 중입니다.", summoner.RiotID, tracker.Name()))
-			return
+	if targetType == storage.TargetUser {
+		b.rememberUserLocale(c)
+		return c.Reply(l.T("register.success_dm", summoner.RiotID, name))
+	}
+	return c.Reply(l.T("register.success", summoner.RiotID, name))
+}
+
+// ensureSummoner returns the stored summoner of a resolved player, creating
+// it with its current state if nobody registered it yet, and whether it was created
+func (b *Bot) ensureSummoner(ctx context.Context, tracker game.Tracker, playerInfo *game.PlayerInfo) (*storage.Summoner, bool, error) {
+	// Get initial state
+	initialState, err := tracker.GetCurrentState(ctx, playerInfo.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get initial state", "playerID", playerInfo.ID, "error", err)
+		// Continue without initial state - will be set on first poll
+	}
+
+	// Store summoner
+	summoner := &storage.Summoner{
+		PUUID:    playerInfo.ID,
+		RiotID:   playerInfo.DisplayName,
+		GameType: string(playerInfo.GameType),
+		Region:   "KR", // Default to KR for now
+	}
+
+	if err := b.repo.CreateSummoner(summoner); err != nil {
+		// Check if already exists
+		if !strings.Contains(err.Error(), "UNIQUE constraint") {
+			slog.ErrorContext(ctx, "Failed to save summoner", "error", err)
+			return nil, false, i18n.Errorf("register.failed")
+		}
+		// Try to get existing summoner
+		existing, _ := b.repo.GetSummonerByPUUIDAndGame(playerInfo.ID, string(playerInfo.GameType))
+		if existing == nil {
+			name := gameName(i18n.FromContext(ctx), tracker.Type(), tracker.Name())
+			return nil, false, i18n.Errorf("register.already_registered", summoner.RiotID, name)
 		}
-		slog.Error("Failed to create subscription", "error", err)
-		b.editResponse(s, i, "플레이어는 저장되었으나 구독 생성에 실패했습니다.")
-		return
+		return existing, false, nil
 	}
 
-	b.editResponse(s, i, fmt.Sprintf("`%s`를 %s 추적에 성공적으로 등록했습니다!", summoner.RiotID, tracker.Name()))
+	// Only a newly created summoner takes the initial state
+	if initialState != nil {
+		if err := b.repo.UpsertPlayerState(&storage.PlayerState{
+			SummonerID: summoner.ID,
+			Version:    initialState.Version,
+			Data:       string(initialState.Data),
+		}); err != nil {
+			slog.WarnContext(ctx, "Failed to save initial state", "summoner", summoner.RiotID, "error", err)
+		}
+	}
+	return summoner, true, nil
 }
 
 // handleUnregister handles the /unregister command
-func (b *Bot) handleUnregister(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	gameType := options[0].StringValue()
-	playerID := options[1].StringValue()
-
-	// Get the tracker for this game
-	tracker, err := b.registry.Get(game.GameType(gameType))
-	if err != nil {
-		respondWithMessage(s, i, fmt.Sprintf("알 수 없는 게임: `%s`. `/게임목록` 명령어로 지원되는 게임을 확인하세요.", gameType))
-		return
+func (b *Bot) handleUnregister(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
 	}
+	l := c.Locale
 
-	// Validate and normalize player ID
-	if err := tracker.ValidatePlayerID(playerID); err != nil {
-		respondWithMessage(s, i, fmt.Sprintf("잘못된 플레이어 ID 형식: %s", err.Error()))
-		return
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
 	}
+	name := gameName(l, tracker.Type(), tracker.Name())
 
 	// Find summoner
-	summoner, err := b.repo.GetSummonerByRiotIDAndGame(playerID, gameType)
+	summoner, err := b.repo.GetSummonerByRiotIDAndGame(opts.Player, opts.Game)
 	if err != nil {
-		respondWithMessage(s, i, fmt.Sprintf("플레이어 `%s`는 %s에 등록되어 있지 않습니다.", playerID, tracker.Name()))
-		return
+		return i18n.Errorf("unregister.not_registered", opts.Player, name)
 	}
 
-	// Delete subscription for this guild
-	if err := b.repo.DeleteSubscription(summoner.ID, i.GuildID); err != nil {
-		slog.Error("Failed to delete subscription", "error", err)
-		respondWithMessage(s, i, "플레이어 등록 해제에 실패했습니다. 다시 시도해주세요.")
-		return
+	// Delete subscription for this guild, or the invoking user in DMs
+	_, targetID := c.Target()
+	if err := b.repo.DeleteSubscription(summoner.ID, targetID); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to delete subscription", "error", err)
+		return i18n.Errorf("unregister.failed")
 	}
 
-	respondWithMessage(s, i, fmt.Sprintf("`%s`를 %s 추적에서 성공적으로 해제했습니다.", playerID, tracker.Name()))
+	return c.Reply(l.T("unregister.success", opts.Player, name))
 }
 
 // handleList handles the /list command
-func (b *Bot) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	summoners, err := b.repo.GetSummonersByGuild(i.GuildID)
+func (b *Bot) handleList(c *Context) error {
+	l := c.Locale
+	targetType, targetID := c.Target()
+	personal := targetType == storage.TargetUser
+
+	summoners, err := b.repo.GetSummonersByGuild(targetID)
 	if err != nil {
-		slog.Error("Failed to get summoners", "error", err)
-		respondWithMessage(s, i, "플레이어 목록을 가져오는 데 실패했습니다.")
-		return
+		slog.ErrorContext(c.Context(), "Failed to get summoners", "error", err)
+		return i18n.Errorf("list.failed")
 	}
 
 	if len(summoners) == 0 {
-		respondWithMessage(s, i, "이 서버에 등록된 플레이어가 없습니다.\n`/등록` 명령어로 추가하세요!\n`/게임목록` 명령어로 지원되는 게임을 확인하세요.")
-		return
+		if personal {
+			return c.Reply(l.T("list.empty_dm"))
+		}
+		return c.Reply(l.T("list.empty"))
+	}
+
+	// Players whose notifications were muted from a notification button
+	muted := make(map[int64]bool)
+	if subs, err := b.repo.GetSubscriptionsByGuild(targetID); err == nil {
+		for _, sub := range subs {
+			muted[sub.SummonerID] = sub.Muted
+		}
+	}
+
+	// Players whose owner proved the account is theirs with /연동
+	verified := make(map[int64]bool)
+	if links, err := b.repo.GetPlayerLinksByGuild(targetID); err == nil {
+		for _, link := range links {
+			verified[link.SummonerID] = link.Verified
+		}
 	}
 
 	// Group summoners by game type
@@ -326,126 +375,160 @@ func (b *Bot) handleList(s *discordgo.Session, i *discordgo.InteractionCreate) {
 
 	// Build list
 	var sb strings.Builder
-	sb.WriteString("**등록된 플레이어:**\n\n")
+	if personal {
+		sb.WriteString(l.T("list.title_dm") + "\n\n")
+	} else {
+		sb.WriteString(l.T("list.title") + "\n\n")
+	}
 
-	for gameType, players := range byGame {
+	// Enabled games in registry order, then players of disabled games
+	var order []string
+	for _, g := range b.registry.List() {
+		if _, ok := byGame[string(g.Type)]; ok {
+			order = append(order, string(g.Type))
+		}
+	}
+	var disabled []string
+	for gameType := range byGame {
+		if !slices.Contains(order, gameType) {
+			disabled = append(disabled, gameType)
+		}
+	}
+	sort.Strings(disabled)
+	order = append(order, disabled...)
+
+	for _, gameType := range order {
+		players := byGame[gameType]
 		// Get game name
-		gameName := gameType
+		name := gameType
 		if tracker, err := b.registry.Get(game.GameType(gameType)); err == nil {
-			gameName = tracker.Name()
+			name = gameName(l, tracker.Type(), tracker.Name())
 		}
 
-		sb.WriteString(fmt.Sprintf("**%s:**\n", gameName))
+		sb.WriteString(fmt.Sprintf("**%s:**\n", name))
 		for idx, summoner := range players {
-			sb.WriteString(fmt.Sprintf("  %d. `%s`\n", idx+1, summoner.RiotID))
+			mark := ""
+			if verified[summoner.ID] {
+				mark += " ✅"
+			}
+			if muted[summoner.ID] {
+				mark += " 🔕"
+			}
+			sb.WriteString(fmt.Sprintf("  %d. `%s`%s\n", idx+1, summoner.RiotID, mark))
 		}
 		sb.WriteString("\n")
 	}
 
-	respondWithMessage(s, i, sb.String())
+	return c.Reply(sb.String())
 }
 
 // handleSetChannel handles the /setchannel command
-func (b *Bot) handleSetChannel(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	channel := i.ApplicationCommandData().Options[0].ChannelValue(s)
+func (b *Bot) handleSetChannel(c *Context) error {
+	var opts struct {
+		Channel *discordgo.Channel `option:"채널"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
 
 	settings := &storage.GuildSettings{
-		GuildID:               i.GuildID,
-		NotificationChannelID: channel.ID,
+		GuildID:               c.Interaction.GuildID,
+		NotificationChannelID: opts.Channel.ID,
 	}
 
 	if err := b.repo.UpsertGuildSettings(settings); err != nil {
-		slog.Error("Failed to save guild settings", "error", err)
-		respondWithMessage(s, i, "알림 채널 설정에 실패했습니다. 다시 시도해주세요.")
-		return
+		slog.ErrorContext(c.Context(), "Failed to save guild settings", "error", err)
+		return i18n.Errorf("setchannel.failed")
 	}
 
-	respondWithMessage(s, i, fmt.Sprintf("게임 알림이 <#%s> 채널로 전송됩니다", channel.ID))
+	return c.Reply(c.Locale.T("setchannel.success", opts.Channel.ID))
 }
 
 // handleGames handles the /games command
-func (b *Bot) handleGames(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func (b *Bot) handleGames(c *Context) error {
 	games := b.registry.List()
+	disabled := b.registry.ListDisabled()
+	l := c.Locale
 
-	if len(games) == 0 {
-		respondWithMessage(s, i, "현재 지원되는 게임이 없습니다.")
-		return
+	if len(games) == 0 && len(disabled) == 0 {
+		return c.Reply(l.T("games.none"))
 	}
 
 	var sb strings.Builder
-	sb.WriteString("**지원되는 게임:**\n\n")
+	if len(games) > 0 {
+		sb.WriteString(l.T("games.enabled") + "\n\n")
+		for _, g := range games {
+			sb.WriteString(fmt.Sprintf("**%s** (`%s`)\n", gameName(l, g.Type, g.Name), g.Type))
+			sb.WriteString(fmt.Sprintf("  %s\n\n", gameDescription(l, g)))
+		}
+	}
 
-	for _, g := range games {
-		sb.WriteString(fmt.Sprintf("**%s** (`%s`)\n", g.Name, g.Type))
-		sb.WriteString(fmt.Sprintf("  %s\n\n", g.Description))
+	if len(disabled) > 0 {
+		sb.WriteString(l.T("games.disabled") + "\n")
+		for _, g := range disabled {
+			sb.WriteString(fmt.Sprintf("~~%s~~ (`%s`) - %s\n", gameName(l, g.Type, g.Name), g.Type, i18n.Message(l, g.Reason)))
+		}
+		sb.WriteString("\n")
 	}
 
-	sb.WriteString("`/등록 게임:<게임> 플레이어:<ID>` 명령어로 추적을 시작하세요!")
+	sb.WriteString(l.T("games.hint"))
 
-	respondWithMessage(s, i, sb.String())
+	return c.Reply(sb.String())
 }
 
 // handleRecent handles the /최근 command - shows most recent tracker data
-func (b *Bot) handleRecent(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	gameType := options[0].StringValue()
-	playerID := options[1].StringValue()
-
-	// Respond immediately to avoid timeout
-	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
-	})
-
-	// Get the tracker for this game
-	tracker, err := b.registry.Get(game.GameType(gameType))
-	if err != nil {
-		b.editResponse(s, i, fmt.Sprintf("알 수 없는 게임: `%s`. `/게임목록` 명령어로 지원되는 게임을 확인하세요.", gameType))
-		return
+func (b *Bot) handleRecent(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
 	}
+	l := c.Locale
 
-	// Validate player ID format
-	if err := tracker.ValidatePlayerID(playerID); err != nil {
-		b.editResponse(s, i, fmt.Sprintf("잘못된 플레이어 ID 형식: %s", err.Error()))
-		return
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
 	}
 
 	// Find summoner in database
-	summoner, err := b.repo.GetSummonerByRiotIDAndGame(playerID, gameType)
+	summoner, err := b.repo.GetSummonerByRiotIDAndGame(opts.Player, opts.Game)
 	if err != nil {
-		b.editResponse(s, i, fmt.Sprintf("플레이어 `%s`는 %s에 등록되어 있지 않습니다. `/등록` 명령어로 먼저 등록해주세요.", playerID, tracker.Name()))
-		return
+		return i18n.Errorf("recent.not_registered", opts.Player, gameName(l, tracker.Type(), tracker.Name()))
 	}
 
 	// Check if we have stored state
-	if summoner.LastMatchID == "" {
-		b.editResponse(s, i, fmt.Sprintf("`%s`의 최근 데이터가 아직 없습니다. 잠시 후 다시 시도해주세요.", summoner.RiotID))
-		return
+	stored, err := b.repo.GetPlayerState(summoner)
+	if err != nil || stored == nil {
+		return i18n.Errorf("recent.no_data", summoner.RiotID)
 	}
 
 	// Create notification embed using stored state
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
 	defer cancel()
 
-	embed, err := tracker.CreateNotification(ctx, summoner.PUUID, summoner.RiotID, summoner.LastMatchID)
+	embed, err := tracker.CreateNotification(ctx, summoner.PUUID, summoner.RiotID, game.StateChange{
+		Current: &game.State{Version: stored.Version, Data: json.RawMessage(stored.Data)},
+	})
 	if err != nil {
-		slog.Error("Failed to create notification", "error", err)
-		b.editResponse(s, i, fmt.Sprintf("`%s`의 최근 데이터를 가져오는데 실패했습니다.", summoner.RiotID))
-		return
+		slog.ErrorContext(c.Context(), "Failed to create notification", "error", err)
+		return i18n.Errorf("recent.failed", summoner.RiotID)
 	}
 
-	// Edit response with embed
-	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-		Embeds: &[]*discordgo.MessageEmbed{embed},
-	})
+	if embed == nil {
+		return i18n.Errorf("recent.no_data", summoner.RiotID)
+	}
+
+	return c.ReplyEmbeds(embed)
 }
 
 // Helper functions
 
-func respondWithMessage(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+// respondEphemeral responds with a message only the invoking user can see
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
 			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
 		},
 	})
 }
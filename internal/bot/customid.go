@@ -0,0 +1,57 @@
+package bot
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// signatureLength is the number of base64 characters kept from the HMAC
+const signatureLength = 16
+
+// customIDSigner signs component custom IDs so buttons can carry their
+// arguments without server-side state
+// Signatures bind the arguments to a guild, so buttons can't be replayed elsewhere
+type customIDSigner struct {
+	key []byte
+}
+
+// newCustomIDSigner creates a signer keyed by secret
+func newCustomIDSigner(secret string) *customIDSigner {
+	return &customIDSigner{key: []byte(secret)}
+}
+
+// sign returns "prefix:args...:signature" for a button shown in a guild
+func (c *customIDSigner) sign(guildID, prefix string, args ...string) string {
+	payload := strings.Join(args, ":")
+	return prefix + ":" + payload + ":" + c.signature(guildID, prefix, payload)
+}
+
+// verify checks the signature of the args part of a custom ID
+// Returns the arguments, or false if the signature doesn't match
+func (c *customIDSigner) verify(guildID, prefix, args string) ([]string, bool) {
+	payload, sig, ok := cutLast(args, ":")
+	if !ok {
+		return nil, false
+	}
+	if !hmac.Equal([]byte(sig), []byte(c.signature(guildID, prefix, payload))) {
+		return nil, false
+	}
+	return strings.Split(payload, ":"), true
+}
+
+// signature returns the truncated HMAC of a guild, prefix and payload
+func (c *customIDSigner) signature(guildID, prefix, payload string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(guildID + "\x00" + prefix + "\x00" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))[:signatureLength]
+}
+
+// cutLast slices s around the last instance of sep
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
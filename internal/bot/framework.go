@@ -0,0 +1,416 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+	"github.com/flor3z/discord-bot/internal/trace"
+)
+
+// RunFunc runs a command invocation
+// Errors are replied to the invoking user ephemerally: *i18n.Error messages
+// are shown as is, anything else as a generic failure with a reference code
+type RunFunc func(c *Context) error
+
+// Middleware wraps a RunFunc with shared behavior
+type Middleware func(next RunFunc) RunFunc
+
+// Spec declares a slash command run through the command framework
+type Spec struct {
+	Definition *discordgo.ApplicationCommand
+
+	// Middleware runs in order around Run, inside panic recovery and logging
+	Middleware []Middleware
+
+	Run RunFunc
+}
+
+// Context carries one command invocation through middleware to its handler
+type Context struct {
+	Session     *discordgo.Session
+	Interaction *discordgo.InteractionCreate
+	Locale      i18n.Locale
+
+	// ctx carries the interaction's correlation ID
+	ctx context.Context
+
+	// Response state, used to pick between responding, editing and following up
+	responded bool
+	deferred  bool
+	ephemeral bool
+}
+
+// command turns a spec into a registrable command
+func (b *Bot) command(spec Spec) Command {
+	middleware := append([]Middleware{recoverPanics, logCommand}, spec.Middleware...)
+	run := spec.Run
+	for i := len(middleware) - 1; i >= 0; i-- {
+		run = middleware[i](run)
+	}
+
+	return Command{
+		Definition: spec.Definition,
+		Handler: func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+			c := &Context{Session: s, Interaction: i, Locale: b.locale(i), ctx: ctx}
+			if err := run(c); err != nil {
+				c.fail(err)
+			}
+		},
+	}
+}
+
+// Context returns a context carrying the invocation's locale and correlation ID
+func (c *Context) Context() context.Context {
+	return i18n.WithLocale(c.ctx, c.Locale)
+}
+
+// UserID returns the ID of the invoking user
+func (c *Context) UserID() string {
+	return interactionUserID(c.Interaction)
+}
+
+// Target returns who the invocation's subscriptions belong to
+func (c *Context) Target() (storage.TargetType, string) {
+	return subscriptionTarget(c.Interaction)
+}
+
+// interactionUserID returns the ID of the user behind an interaction
+// Member is set in guilds and User in DMs
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// subscriptionTarget returns who an interaction's subscriptions belong to:
+// the guild, or the invoking user in DMs
+func subscriptionTarget(i *discordgo.InteractionCreate) (storage.TargetType, string) {
+	if i.GuildID != "" {
+		return storage.TargetGuild, i.GuildID
+	}
+	return storage.TargetUser, interactionUserID(i)
+}
+
+// Reply sends the command's response, editing it if it was deferred
+func (c *Context) Reply(content string) error {
+	return c.respond(&discordgo.InteractionResponseData{Content: content})
+}
+
+// ReplyEmbeds sends embeds as the command's response, editing it if it was deferred
+func (c *Context) ReplyEmbeds(embeds ...*discordgo.MessageEmbed) error {
+	return c.respond(&discordgo.InteractionResponseData{Embeds: embeds})
+}
+
+// ReplyEphemeral sends a response only the invoking user can see
+// A deferred response keeps the visibility chosen when deferring
+func (c *Context) ReplyEphemeral(content string) error {
+	return c.respond(&discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral})
+}
+
+// ReplyMessage sends a response with components or files, editing it if it was deferred
+func (c *Context) ReplyMessage(data *discordgo.InteractionResponseData) error {
+	return c.respond(data)
+}
+
+// respond sends or edits the response
+// Mentions in responses are shown without pinging anyone
+func (c *Context) respond(data *discordgo.InteractionResponseData) error {
+	data.AllowedMentions = &discordgo.MessageAllowedMentions{Parse: []discordgo.AllowedMentionType{}}
+	if c.deferred {
+		edit := &discordgo.WebhookEdit{
+			Content:         &data.Content,
+			Embeds:          &data.Embeds,
+			Files:           data.Files,
+			AllowedMentions: data.AllowedMentions,
+		}
+		if data.Components != nil {
+			edit.Components = &data.Components
+		}
+		_, err := c.Session.InteractionResponseEdit(c.Interaction.Interaction, edit)
+		return err
+	}
+
+	c.responded = true
+	return c.Session.InteractionRespond(c.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: data,
+	})
+}
+
+// fail replies to the invoking user with an error only they can see
+// A public deferred response is replaced by an ephemeral follow-up
+func (c *Context) fail(err error) {
+	var userErr *i18n.Error
+	if !errors.As(err, &userErr) {
+		slog.ErrorContext(c.ctx, "Command failed", "command", c.Interaction.ApplicationCommandData().Name, "error", err)
+		err = i18n.Errorf("error.internal", trace.ID(c.ctx))
+	}
+	content := i18n.Message(c.Locale, err)
+
+	switch {
+	case c.deferred && c.ephemeral:
+		c.Session.InteractionResponseEdit(c.Interaction.Interaction, &discordgo.WebhookEdit{Content: &content})
+	case c.deferred || c.responded:
+		if c.deferred {
+			c.Session.InteractionResponseDelete(c.Interaction.Interaction)
+		}
+		c.Session.FollowupMessageCreate(c.Interaction.Interaction, true, &discordgo.WebhookParams{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		})
+	default:
+		c.responded = true
+		respondEphemeral(c.Session, c.Interaction, content)
+	}
+}
+
+// replyInternalError tells the user an interaction failed, with a reference
+// code to find its logs, whether or not it was already acknowledged
+func replyInternalError(s *discordgo.Session, i *discordgo.InteractionCreate, l i18n.Locale, id string) {
+	content := l.T("error.internal", id)
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		})
+	}
+}
+
+// Subcommand returns the name of the invoked subcommand, if any
+func (c *Context) Subcommand() string {
+	options := c.Interaction.ApplicationCommandData().Options
+	if len(options) > 0 && options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		return options[0].Name
+	}
+	return ""
+}
+
+// Bind parses the invocation's options into dst, a pointer to a struct
+// whose fields are tagged with option names, e.g. `option:"게임"`
+// Options of an invoked subcommand are read instead of the command's
+// Supported field types: string, int, int64, float64, bool,
+// *discordgo.Channel, *discordgo.User and *discordgo.Role
+// Pointers to the scalar types stay nil when an optional option is absent
+func (c *Context) Bind(dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind: %T is not a pointer to a struct", dst)
+	}
+
+	options := c.Interaction.ApplicationCommandData().Options
+	if len(options) > 0 && options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		options = options[0].Options
+	}
+	byName := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		byName[opt.Name] = opt
+	}
+
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name, ok := v.Type().Field(i).Tag.Lookup("option")
+		if !ok {
+			continue
+		}
+		opt, ok := byName[name]
+		if !ok {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.Pointer && field.Type().Elem().Kind() != reflect.Struct {
+			value := reflect.New(field.Type().Elem())
+			if err := c.setOption(value.Elem(), opt); err != nil {
+				return fmt.Errorf("bind option %s: %w", name, err)
+			}
+			field.Set(value)
+			continue
+		}
+		if err := c.setOption(field, opt); err != nil {
+			return fmt.Errorf("bind option %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// setOption stores an option value in a struct field
+func (c *Context) setOption(field reflect.Value, opt *discordgo.ApplicationCommandInteractionDataOption) error {
+	mismatch := fmt.Errorf("cannot store option type %d in %s", opt.Type, field.Type())
+
+	switch field.Interface().(type) {
+	case string:
+		if opt.Type != discordgo.ApplicationCommandOptionString {
+			return mismatch
+		}
+		field.SetString(opt.StringValue())
+	case int, int64:
+		if opt.Type != discordgo.ApplicationCommandOptionInteger {
+			return mismatch
+		}
+		field.SetInt(opt.IntValue())
+	case float64:
+		if opt.Type != discordgo.ApplicationCommandOptionNumber {
+			return mismatch
+		}
+		field.SetFloat(opt.FloatValue())
+	case bool:
+		if opt.Type != discordgo.ApplicationCommandOptionBoolean {
+			return mismatch
+		}
+		field.SetBool(opt.BoolValue())
+	case *discordgo.Channel:
+		if opt.Type != discordgo.ApplicationCommandOptionChannel {
+			return mismatch
+		}
+		field.Set(reflect.ValueOf(opt.ChannelValue(c.Session)))
+	case *discordgo.User:
+		if opt.Type != discordgo.ApplicationCommandOptionUser {
+			return mismatch
+		}
+		field.Set(reflect.ValueOf(opt.UserValue(c.Session)))
+	case *discordgo.Role:
+		if opt.Type != discordgo.ApplicationCommandOptionRole {
+			return mismatch
+		}
+		field.Set(reflect.ValueOf(opt.RoleValue(c.Session, c.Interaction.GuildID)))
+	default:
+		return mismatch
+	}
+	return nil
+}
+
+// recoverPanics turns a panicking handler into an error reply
+func recoverPanics(next RunFunc) RunFunc {
+	return func(c *Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.ErrorContext(c.ctx, "Command panicked", "command", c.Interaction.ApplicationCommandData().Name,
+					"panic", r, "stack", string(debug.Stack()))
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return next(c)
+	}
+}
+
+// logCommand logs every invocation with its outcome and duration
+func logCommand(next RunFunc) RunFunc {
+	return func(c *Context) error {
+		start := time.Now()
+		err := next(c)
+		slog.DebugContext(c.ctx, "Command handled",
+			"command", c.Interaction.ApplicationCommandData().Name,
+			"guild", c.Interaction.GuildID,
+			"user", c.UserID(),
+			"duration", time.Since(start),
+			"error", err)
+		return err
+	}
+}
+
+// deferReply acknowledges the command right away for handlers that call
+// slow APIs; replies then edit the deferred response
+func deferReply(ephemeral bool) Middleware {
+	return func(next RunFunc) RunFunc {
+		return func(c *Context) error {
+			var flags discordgo.MessageFlags
+			if ephemeral {
+				flags = discordgo.MessageFlagsEphemeral
+			}
+			if err := c.Session.InteractionRespond(c.Interaction.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{Flags: flags},
+			}); err != nil {
+				return fmt.Errorf("failed to defer response: %w", err)
+			}
+			c.responded, c.deferred, c.ephemeral = true, true, ephemeral
+			return next(c)
+		}
+	}
+}
+
+// requireGuild rejects invocations outside a guild
+// Discord already hides commands from DMs through their contexts
+func requireGuild(next RunFunc) RunFunc {
+	return func(c *Context) error {
+		if c.Interaction.GuildID == "" {
+			return i18n.Errorf("error.guild_only")
+		}
+		return next(c)
+	}
+}
+
+// requirePermission rejects members lacking a permission
+// Discord already hides commands with DefaultMemberPermissions, but server
+// admins can override that per command
+func requirePermission(permission int64) Middleware {
+	return func(next RunFunc) RunFunc {
+		return func(c *Context) error {
+			member := c.Interaction.Member
+			if member == nil || member.Permissions&permission == 0 {
+				return i18n.Errorf("error.forbidden")
+			}
+			return next(c)
+		}
+	}
+}
+
+// cooldown limits how often each user may run a command
+func cooldown(d time.Duration) Middleware {
+	var mu sync.Mutex
+	last := make(map[string]time.Time)
+
+	return func(next RunFunc) RunFunc {
+		return func(c *Context) error {
+			now := time.Now()
+			user := c.UserID()
+
+			mu.Lock()
+			if wait := d - now.Sub(last[user]); wait > 0 {
+				mu.Unlock()
+				return i18n.Errorf("error.cooldown", int(wait.Seconds())+1)
+			}
+			for id, at := range last {
+				if now.Sub(at) >= d {
+					delete(last, id)
+				}
+			}
+			last[user] = now
+			mu.Unlock()
+
+			return next(c)
+		}
+	}
+}
+
+// trackerFor returns the tracker of a game after validating a player ID for it
+func (b *Bot) trackerFor(gameType, playerID string) (game.Tracker, error) {
+	tracker, err := b.registry.Get(game.GameType(gameType))
+	if err != nil {
+		return nil, i18n.Errorf("error.unknown_game", gameType)
+	}
+	if err := tracker.ValidatePlayerID(playerID); err != nil {
+		return nil, i18n.Wrap(err, "error.invalid_player")
+	}
+	return tracker, nil
+}
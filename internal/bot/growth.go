@@ -0,0 +1,260 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/color"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/chart"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/games/maplestory"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// snapshotInterval is how often the daily snapshot loop looks for new days
+const snapshotInterval = 6 * time.Hour
+
+// fillSnapshots stores any missing daily snapshots of a MapleStory character
+// for the last n days and returns how many were added
+func (b *Bot) fillSnapshots(ctx context.Context, summoner *storage.Summoner, n int) (int, error) {
+	dates := maplestory.HistoryDates(time.Now(), n)
+	if len(dates) == 0 {
+		return 0, nil
+	}
+
+	existing, err := b.repo.GetCharacterSnapshots(summoner.ID, nexon.FormatDate(dates[0]), nexon.FormatDate(dates[len(dates)-1]))
+	if err != nil {
+		return 0, fmt.Errorf("failed to load snapshots: %w", err)
+	}
+	have := make(map[string]bool, len(existing))
+	for _, snap := range existing {
+		have[snap.Date] = true
+	}
+
+	added := 0
+	for _, date := range dates {
+		if have[nexon.FormatDate(date)] {
+			continue
+		}
+		if ctx.Err() != nil {
+			return added, ctx.Err()
+		}
+
+		snap, err := b.maplestory.GetDailySnapshot(ctx, summoner.PUUID, date)
+		if err != nil {
+			slog.DebugContext(ctx, "Skipping snapshot", "character", summoner.RiotID, "date", nexon.FormatDate(date), "error", err)
+			continue
+		}
+
+		if err := b.repo.UpsertCharacterSnapshot(&storage.CharacterSnapshot{
+			SummonerID: summoner.ID,
+			Date:       snap.Date,
+			Level:      snap.Level,
+			Exp:        snap.Exp,
+			ExpRate:    snap.ExpRate,
+		}); err != nil {
+			return added, fmt.Errorf("failed to save snapshot: %w", err)
+		}
+		added++
+	}
+
+	return added, nil
+}
+
+// backfillSnapshots fills the configured history window for a newly registered character
+func (b *Bot) backfillSnapshots(summoner *storage.Summoner) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	added, err := b.fillSnapshots(ctx, summoner, b.config.MaplestoryBackfillDays)
+	if err != nil {
+		slog.Error("Failed to backfill snapshots", "character", summoner.RiotID, "error", err)
+		return
+	}
+	slog.Info("Backfilled snapshots", "character", summoner.RiotID, "added", added)
+}
+
+// runSnapshotLoop records the previous day's snapshot for every tracked character
+func (b *Bot) runSnapshotLoop(ctx context.Context) {
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		summoners, err := b.repo.GetAllSummonersByGame(string(game.GameTypeMaplestory))
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to get characters for snapshots", "error", err)
+		}
+		for _, summoner := range summoners {
+			// Two days covers a missed run around midnight
+			if _, err := b.fillSnapshots(ctx, summoner, 2); err != nil {
+				slog.ErrorContext(ctx, "Failed to record snapshot", "character", summoner.RiotID, "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleGrowth handles the /성장 command
+func (b *Bot) handleGrowth(c *Context) error {
+	opts := struct {
+		Character string `option:"캐릭터"`
+		Days      int    `option:"기간"`
+	}{Days: 7}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	days := opts.Days
+
+	summoner, err := b.repo.GetSummonerByRiotIDAndGame(opts.Character, string(game.GameTypeMaplestory))
+	if err != nil {
+		return i18n.Errorf("growth.not_registered", opts.Character)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 30*time.Second)
+	defer cancel()
+
+	// Fill any gaps on demand so the chart is complete
+	if _, err := b.fillSnapshots(ctx, summoner, days+1); err != nil {
+		slog.WarnContext(ctx, "Failed to fill snapshots", "character", summoner.RiotID, "error", err)
+	}
+
+	dates := maplestory.HistoryDates(time.Now(), days+1)
+	if len(dates) == 0 {
+		return i18n.Errorf("growth.no_period")
+	}
+
+	snaps, err := b.repo.GetCharacterSnapshots(summoner.ID, nexon.FormatDate(dates[0]), nexon.FormatDate(dates[len(dates)-1]))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get snapshots", "error", err)
+		return i18n.Errorf("growth.failed")
+	}
+	if len(snaps) < 2 {
+		return i18n.Errorf("growth.not_enough", summoner.RiotID)
+	}
+
+	embed, png, err := createGrowthReport(c.Locale, summoner.RiotID, days, snaps)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to render growth chart", "error", err)
+		return i18n.Errorf("growth.chart_failed")
+	}
+
+	return c.ReplyMessage(&discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+		Files: []*discordgo.File{
+			{Name: "growth.png", ContentType: "image/png", Reader: bytes.NewReader(png)},
+		},
+	})
+}
+
+// createGrowthReport builds the growth embed and chart from consecutive snapshots
+func createGrowthReport(l i18n.Locale, characterName string, days int, snaps []*storage.CharacterSnapshot) (*discordgo.MessageEmbed, []byte, error) {
+	labels := make([]string, 0, len(snaps)-1)
+	progress := make([]float64, 0, len(snaps)-1)
+	gains := make([]float64, 0, len(snaps)-1)
+
+	var daily strings.Builder
+	for idx := 1; idx < len(snaps); idx++ {
+		prev, cur := snaps[idx-1], snaps[idx]
+		span := daySpan(prev.Date, cur.Date)
+		gain := (cur.Progress() - prev.Progress()) * 100
+
+		labels = append(labels, cur.Date[5:]) // MM-DD
+		progress = append(progress, cur.Progress())
+		// Missing days spread the gain over the whole gap
+		gains = append(gains, gain/float64(span))
+		line := fmt.Sprintf("`%s` Lv.%d %+.3f%%", cur.Date[5:], cur.Level, gain)
+		if span > 1 {
+			line += l.T("growth.span", span)
+		}
+		daily.WriteString(line + "\n")
+	}
+
+	first, last := snaps[0], snaps[len(snaps)-1]
+	total := (last.Progress() - first.Progress()) * 100
+
+	png, err := chart.RenderPNG(chart.Chart{
+		Labels: labels,
+		Panels: []chart.Panel{
+			{Title: "Level", Kind: chart.KindLine, Values: progress, Color: color.RGBA{0xFF, 0x99, 0x00, 0xFF}, Format: "%.2f"},
+			{Title: "Daily EXP %", Kind: chart.KindBar, Values: gains, Color: color.RGBA{0x2E, 0xCC, 0x71, 0xFF}, Format: "%.1f"},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	title := l.T("growth.title_weekly")
+	if days > 7 {
+		title = l.T("growth.title_monthly")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: title,
+		Color: 0xFF9900, // Orange color for MapleStory
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: characterName,
+		},
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   l.T("maple.level"),
+				Value:  fmt.Sprintf("%d (%.3f%%) → %d (%.3f%%)", first.Level, first.ExpRate, last.Level, last.ExpRate),
+				Inline: false,
+			},
+			{
+				Name:   l.T("growth.total"),
+				Value:  fmt.Sprintf("%+.3f%%", total),
+				Inline: true,
+			},
+			{
+				Name:   l.T("growth.daily_average"),
+				Value:  fmt.Sprintf("%+.3f%%", total/float64(daySpan(first.Date, last.Date))),
+				Inline: true,
+			},
+			{
+				Name:   l.T("growth.daily"),
+				Value:  truncateField(daily.String()),
+				Inline: false,
+			},
+		},
+		Image: &discordgo.MessageEmbedImage{
+			URL: "attachment://growth.png",
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%s ~ %s", first.Date, last.Date),
+		},
+	}
+
+	return embed, png, nil
+}
+
+// daySpan returns the number of days between two snapshot dates, at least one
+func daySpan(from, to string) int {
+	start, errStart := time.Parse(time.DateOnly, from)
+	end, errEnd := time.Parse(time.DateOnly, to)
+	if errStart != nil || errEnd != nil {
+		return 1
+	}
+	return max(int(end.Sub(start).Hours()/24), 1)
+}
+
+// truncateField shortens text to fit in an embed field value
+func truncateField(s string) string {
+	const limit = 1024
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,147 @@
+package bot
+
+import (
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// languageAuto is the /언어 choice that follows each member's Discord locale
+const languageAuto = "auto"
+
+// locale returns the language to answer an interaction in: the guild's
+// configured language, else the member's Discord locale, else the guild's
+func (b *Bot) locale(i *discordgo.InteractionCreate) i18n.Locale {
+	if l, ok := b.configuredLocale(i.GuildID); ok {
+		return l
+	}
+	if l, ok := i18n.FromDiscord(i.Locale); ok {
+		return l
+	}
+	if i.GuildLocale != nil {
+		if l, ok := i18n.FromDiscord(*i.GuildLocale); ok {
+			return l
+		}
+	}
+	return i18n.Default
+}
+
+// guildLocale returns the language of messages posted to a guild without an
+// interaction, such as notifications
+func (b *Bot) guildLocale(guildID string) i18n.Locale {
+	if l, ok := b.configuredLocale(guildID); ok {
+		return l
+	}
+	return i18n.Default
+}
+
+// configuredLocale returns the language set with /언어, if any
+func (b *Bot) configuredLocale(guildID string) (i18n.Locale, bool) {
+	if guildID == "" {
+		return "", false
+	}
+	settings, err := b.repo.GetGuildSettings(guildID)
+	if err != nil {
+		return "", false
+	}
+	return i18n.Parse(settings.Language)
+}
+
+// rememberUserLocale stores the invoking user's Discord locale as the
+// language of their personal notifications, which have no guild to follow
+func (b *Bot) rememberUserLocale(c *Context) {
+	l, ok := i18n.FromDiscord(c.Interaction.Locale)
+	if !ok {
+		l = i18n.Default
+	}
+	if err := b.repo.SetUserLanguage(c.UserID(), string(l)); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save user language", "userID", c.UserID(), "error", err)
+	}
+}
+
+// gameName returns the localized name of a game
+// Games without a catalog entry, such as custom trackers, keep their own name
+func gameName(l i18n.Locale, gameType game.GameType, name string) string {
+	if localized, ok := l.Lookup("game." + string(gameType)); ok {
+		return localized
+	}
+	return name
+}
+
+// gameDescription returns the localized description of a game
+// Games without one, such as custom trackers, get a generic description
+func gameDescription(l i18n.Locale, g game.GameInfo) string {
+	if desc, ok := l.Lookup("game." + string(g.Type) + ".desc"); ok {
+		return desc
+	}
+	if g.Description == "" {
+		return l.T("games.default_desc", gameName(l, g.Type, g.Name))
+	}
+	return g.Description
+}
+
+// languageCommands returns the /언어 command
+func (b *Bot) languageCommands() []Command {
+	choices := []*discordgo.ApplicationCommandOptionChoice{
+		{Name: "각 멤버의 디스코드 언어", Value: languageAuto},
+	}
+	for _, l := range i18n.Supported() {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: l.Name(), Value: string(l)})
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:                     "언어",
+				Description:              "이 서버의 봇 언어를 설정합니다",
+				DefaultMemberPermissions: &manageGuildPermission,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "언어",
+						Description: "언어 (비워두면 현재 설정 표시)",
+						Choices:     choices,
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission)},
+			Run:        b.handleLanguage,
+		}),
+	}
+}
+
+// handleLanguage handles the /언어 command
+func (b *Bot) handleLanguage(c *Context) error {
+	var opts struct {
+		Language *string `option:"언어"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	guildID := c.Interaction.GuildID
+	if opts.Language == nil {
+		if l, ok := b.configuredLocale(guildID); ok {
+			return c.ReplyEphemeral(l.T("language.current", l.Name()))
+		}
+		return c.ReplyEphemeral(c.Locale.T("language.auto"))
+	}
+
+	language := *opts.Language
+	if language == languageAuto {
+		language = ""
+	}
+	if err := b.repo.SetGuildLanguage(guildID, language); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save guild language", "guildID", guildID, "error", err)
+		return i18n.Errorf("language.failed")
+	}
+
+	// Answer in the newly selected language
+	l := b.locale(c.Interaction)
+	if language == "" {
+		return c.Reply(l.T("language.auto"))
+	}
+	return c.Reply(l.T("language.set", l.Name()))
+}
@@ -0,0 +1,272 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/leaderboard"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// leaderboardPagePrefix is the custom ID prefix of /랭킹 pagination buttons
+const leaderboardPagePrefix = "rank"
+
+// leaderboardCommands returns the leaderboard commands
+func (b *Bot) leaderboardCommands() []Command {
+	metricChoices := make([]*discordgo.ApplicationCommandOptionChoice, len(leaderboard.Metrics))
+	for idx, m := range leaderboard.Metrics {
+		metricChoices[idx] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  m.Label(i18n.Default),
+			Value: string(m),
+		}
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "랭킹",
+				Description: "이 서버에 등록된 플레이어 순위를 보여줍니다",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "지표",
+						Description: "순위 기준",
+						Required:    true,
+						Choices:     metricChoices,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "경기수",
+						Description: fmt.Sprintf("승률/KDA를 계산할 최근 경기 수 (기본: %d)", leaderboard.DefaultGames),
+						Required:    false,
+						MinValue:    floatPtr(5),
+						MaxValue:    100,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "고정",
+						Description: "이 채널에 고정하고 확인 주기마다 자동 갱신합니다 (서버 관리 권한 필요)",
+						Required:    false,
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild},
+			Run:        b.handleLeaderboard,
+		}),
+	}
+}
+
+// handleLeaderboard handles the /랭킹 command
+func (b *Bot) handleLeaderboard(c *Context) error {
+	opts := struct {
+		Metric string `option:"지표"`
+		Games  int    `option:"경기수"`
+		Pin    bool   `option:"고정"`
+	}{Games: leaderboard.DefaultGames}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	guildID := c.Interaction.GuildID
+
+	if opts.Pin && c.Interaction.Member.Permissions&manageGuildPermission == 0 {
+		return i18n.Errorf("leaderboard.pin_forbidden")
+	}
+
+	metric := leaderboard.Metric(opts.Metric)
+	board, err := leaderboard.Build(b.repo, b.registry, c.Locale, guildID, metric, opts.Games, time.Now())
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to build leaderboard", "guildID", guildID, "metric", metric, "error", err)
+		return i18n.Errorf("leaderboard.failed")
+	}
+
+	if opts.Pin {
+		return b.pinLeaderboard(c, board)
+	}
+
+	return c.ReplyMessage(&discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{board.Embed(0)},
+		Components: leaderboardButtons(board, 0),
+	})
+}
+
+// handleLeaderboardPage handles /랭킹 pagination buttons
+// args is "<metric>:<games>:<page>"; the board is rebuilt from current data
+func (b *Bot) handleLeaderboardPage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, args string) {
+	parts := strings.Split(args, ":")
+	if len(parts) != 3 {
+		slog.WarnContext(ctx, "Malformed leaderboard button", "args", args)
+		return
+	}
+	games, err1 := strconv.Atoi(parts[1])
+	page, err2 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil {
+		slog.WarnContext(ctx, "Malformed leaderboard button", "args", args)
+		return
+	}
+
+	board, err := leaderboard.Build(b.repo, b.registry, b.locale(i), i.GuildID, leaderboard.Metric(parts[0]), games, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to build leaderboard", "guildID", i.GuildID, "error", err)
+		return
+	}
+
+	components := leaderboardButtons(board, page)
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{board.Embed(page)},
+			Components: components,
+		},
+	})
+}
+
+// leaderboardButtons builds the previous/next buttons for a board page
+// Boards that fit on one page get no buttons
+func leaderboardButtons(board *leaderboard.Board, page int) []discordgo.MessageComponent {
+	l := board.Locale
+	count := board.PageCount()
+	if count <= 1 {
+		return []discordgo.MessageComponent{}
+	}
+	page = min(max(page, 0), count-1)
+	prev := (page + count - 1) % count
+	next := (page + 1) % count
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    l.T("button.previous"),
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s:%s:%d:%d", leaderboardPagePrefix, board.Metric, board.Games, prev),
+				},
+				discordgo.Button{
+					Label:    l.T("button.next"),
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s:%s:%d:%d", leaderboardPagePrefix, board.Metric, board.Games, next),
+				},
+			},
+		},
+	}
+}
+
+// pinLeaderboard posts a board to the current channel, pins it and records it for auto-updates
+// A previously pinned board for the same metric is unpinned
+func (b *Bot) pinLeaderboard(c *Context, board *leaderboard.Board) error {
+	s, i := c.Session, c.Interaction
+	msg, err := s.ChannelMessageSendEmbed(i.ChannelID, pinnedEmbed(board))
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to post leaderboard", "guildID", i.GuildID, "error", err)
+		return i18n.Errorf("leaderboard.post_failed")
+	}
+
+	if err := s.ChannelMessagePin(i.ChannelID, msg.ID); err != nil {
+		slog.WarnContext(c.Context(), "Failed to pin leaderboard", "guildID", i.GuildID, "error", err)
+	}
+
+	old, err := b.repo.GetPinnedLeaderboard(i.GuildID, string(board.Metric))
+	if err == nil && old != nil {
+		s.ChannelMessageUnpin(old.ChannelID, old.MessageID)
+	}
+
+	err = b.repo.UpsertPinnedLeaderboard(&storage.PinnedLeaderboard{
+		GuildID:   i.GuildID,
+		Metric:    string(board.Metric),
+		Games:     board.Games,
+		ChannelID: i.ChannelID,
+		MessageID: msg.ID,
+	})
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save pinned leaderboard", "guildID", i.GuildID, "error", err)
+		return i18n.Errorf("leaderboard.pin_failed")
+	}
+
+	return c.ReplyEphemeral(c.Locale.T("leaderboard.pinned"))
+}
+
+// refreshPinnedLeaderboards updates every pinned board whose content changed
+// Boards whose message was deleted are forgotten
+func (b *Bot) refreshPinnedLeaderboards(ctx context.Context) {
+	pinned, err := b.repo.GetAllPinnedLeaderboards()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get pinned leaderboards", "error", err)
+		return
+	}
+
+	for _, p := range pinned {
+		if ctx.Err() != nil {
+			return
+		}
+
+		board, err := leaderboard.Build(b.repo, b.registry, b.guildLocale(p.GuildID), p.GuildID, leaderboard.Metric(p.Metric), p.Games, time.Now())
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to build leaderboard", "guildID", p.GuildID, "metric", p.Metric, "error", err)
+			continue
+		}
+
+		embed := pinnedEmbed(board)
+		if b.pinnedBoards.unchanged(p.MessageID, embed.Description) {
+			continue
+		}
+
+		_, err = b.session.ChannelMessageEditEmbed(p.ChannelID, p.MessageID, embed)
+		var restErr *discordgo.RESTError
+		if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound {
+			slog.InfoContext(ctx, "Pinned leaderboard deleted", "guildID", p.GuildID, "metric", p.Metric)
+			b.repo.DeletePinnedLeaderboard(p.GuildID, p.Metric)
+			b.pinnedBoards.forget(p.MessageID)
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to update pinned leaderboard", "guildID", p.GuildID, "error", err)
+			b.pinnedBoards.forget(p.MessageID)
+		}
+	}
+}
+
+// pinnedEmbed renders a pinned board: the top page, with an update time
+func pinnedEmbed(board *leaderboard.Board) *discordgo.MessageEmbed {
+	embed := board.Embed(0)
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: board.Locale.T("leaderboard.pinned_footer")}
+	embed.Timestamp = time.Now().Format(time.RFC3339)
+	return embed
+}
+
+// pinnedCache remembers the last content written to each pinned board message
+// so unchanged boards are not edited every poll cycle
+type pinnedCache struct {
+	mu      sync.Mutex
+	content map[string]string // keyed by message ID
+}
+
+// unchanged reports whether a message already shows content, recording it if not
+func (c *pinnedCache) unchanged(messageID, content string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.content == nil {
+		c.content = make(map[string]string)
+	}
+	if c.content[messageID] == content {
+		return true
+	}
+	c.content[messageID] = content
+	return false
+}
+
+// forget drops a message from the cache so it is written again next time
+func (c *pinnedCache) forget(messageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.content, messageID)
+}
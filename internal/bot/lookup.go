@@ -0,0 +1,149 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// lookupCommands returns the /전적, /게임중 and /통계 player lookups
+// Each is offered for the games whose trackers support it, and left out if none do
+func (b *Bot) lookupCommands() []Command {
+	var commands []Command
+
+	if trackers := game.Supporting[game.HistoryProvider](b.registry); len(trackers) > 0 {
+		options := append(b.playerCommandOptionsFor(trackers, "게임"), &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "경기수",
+			Description: "조회할 경기 수 (기본: 10)",
+			MinValue:    floatPtr(1),
+			MaxValue:    recentMatchCount,
+		})
+		commands = append(commands, b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "전적",
+				Description: "플레이어의 최근 경기 기록을 보여줍니다",
+				Contexts:    inGuildsAndDMs(),
+				Options:     options,
+			},
+			Middleware: []Middleware{cooldown(5 * time.Second), deferReply(false)},
+			Run:        b.handleHistory,
+		}))
+	}
+
+	if trackers := game.Supporting[game.LiveStatusProvider](b.registry); len(trackers) > 0 {
+		commands = append(commands, b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "게임중",
+				Description: "플레이어가 지금 게임 중인지 보여줍니다",
+				Contexts:    inGuildsAndDMs(),
+				Options:     b.playerCommandOptionsFor(trackers, "게임"),
+			},
+			Middleware: []Middleware{cooldown(3 * time.Second), deferReply(false)},
+			Run:        b.handleLiveStatus,
+		}))
+	}
+
+	if trackers := game.Supporting[game.StatsProvider](b.registry); len(trackers) > 0 {
+		commands = append(commands, b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "통계",
+				Description: "플레이어의 상세 통계를 보여줍니다",
+				Contexts:    inGuildsAndDMs(),
+				Options:     b.playerCommandOptionsFor(trackers, "게임"),
+			},
+			Middleware: []Middleware{cooldown(3 * time.Second), deferReply(false)},
+			Run:        b.handleStats,
+		}))
+	}
+
+	return commands
+}
+
+// handleHistory handles the /전적 command
+func (b *Bot) handleHistory(c *Context) error {
+	var opts struct {
+		Game   string `option:"게임"`
+		Player string `option:"플레이어"`
+		Count  int64  `option:"경기수"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	count := recentMatchCount
+	if opts.Count > 0 {
+		count = int(opts.Count)
+	}
+
+	return lookup(b, c, playerOptions{Game: opts.Game, Player: opts.Player}, "lookup.no_data",
+		func(ctx context.Context, history game.HistoryProvider, player *game.PlayerInfo) (*discordgo.MessageEmbed, error) {
+			return history.RecentMatches(ctx, player.ID, player.DisplayName, count)
+		})
+}
+
+// handleLiveStatus handles the /게임중 command
+func (b *Bot) handleLiveStatus(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	return lookup(b, c, opts, "lookup.not_playing",
+		func(ctx context.Context, live game.LiveStatusProvider, player *game.PlayerInfo) (*discordgo.MessageEmbed, error) {
+			return live.LiveStatus(ctx, player.ID, player.DisplayName)
+		})
+}
+
+// handleStats handles the /통계 command
+func (b *Bot) handleStats(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	return lookup(b, c, opts, "lookup.no_data",
+		func(ctx context.Context, stats game.StatsProvider, player *game.PlayerInfo) (*discordgo.MessageEmbed, error) {
+			return stats.PlayerStats(ctx, player.ID, player.DisplayName)
+		})
+}
+
+// lookup resolves the player of a lookup command and replies with the embed
+// fetched from the capability C of the game's tracker
+// A nil embed is answered with the emptyKey message instead
+func lookup[C any](b *Bot, c *Context, opts playerOptions, emptyKey string,
+	fetch func(ctx context.Context, provider C, player *game.PlayerInfo) (*discordgo.MessageEmbed, error)) error {
+	l := c.Locale
+
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
+	}
+	provider, ok := tracker.(C)
+	if !ok {
+		return i18n.Errorf("lookup.unsupported", gameName(l, tracker.Type(), tracker.Name()))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 30*time.Second)
+	defer cancel()
+
+	player, err := tracker.ResolvePlayer(ctx, opts.Player)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to look up player", "playerID", opts.Player, "error", err)
+		return i18n.Errorf("register.not_found", opts.Player)
+	}
+
+	embed, err := fetch(ctx, provider, player)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get player details", "player", player.DisplayName, "error", err)
+		return i18n.Wrap(err, "lookup.failed", player.DisplayName)
+	}
+	if embed == nil {
+		return c.Reply(l.T(emptyKey, player.DisplayName))
+	}
+
+	return c.ReplyEmbeds(embed)
+}
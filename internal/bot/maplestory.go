@@ -0,0 +1,155 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/games/maplestory"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// characterPagePrefix is the custom ID prefix of /캐릭터 pagination buttons
+const characterPagePrefix = "maple_char"
+
+// maplestoryCommands returns the MapleStory-specific commands
+func (b *Bot) maplestoryCommands() []Command {
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "캐릭터",
+				Description: "메이플스토리 캐릭터 정보를 조회합니다",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "캐릭터",
+						Description: "캐릭터 이름",
+						Required:    true,
+					},
+				},
+			},
+			Middleware: []Middleware{cooldown(3 * time.Second), deferReply(false)},
+			Run:        b.handleCharacter,
+		}),
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "성장",
+				Description: "등록된 메이플스토리 캐릭터의 레벨/경험치 성장 기록을 그래프로 보여줍니다",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "캐릭터",
+						Description: "캐릭터 이름",
+						Required:    true,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "기간",
+						Description: "조회 기간 (기본: 주간)",
+						Required:    false,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "주간 (7일)", Value: 7},
+							{Name: "월간 (30일)", Value: 30},
+						},
+					},
+				},
+			},
+			Middleware: []Middleware{cooldown(5 * time.Second), deferReply(false)},
+			Run:        b.handleGrowth,
+		}),
+	}
+}
+
+// handleCharacter handles the /캐릭터 command
+func (b *Bot) handleCharacter(c *Context) error {
+	var opts struct {
+		Character string `option:"캐릭터"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	if err := b.maplestory.ValidatePlayerID(opts.Character); err != nil {
+		return i18n.Wrap(err, "character.invalid_name")
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 15*time.Second)
+	defer cancel()
+
+	playerInfo, err := b.maplestory.ResolvePlayer(ctx, opts.Character)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to look up character", "character", opts.Character, "error", err)
+		return i18n.Errorf("character.not_found", opts.Character)
+	}
+
+	embed, err := b.maplestory.CreateCharacterEmbed(ctx, playerInfo.ID, maplestory.PageBasic)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create character embed", "character", opts.Character, "error", err)
+		return i18n.Errorf("character.failed", opts.Character)
+	}
+
+	return c.ReplyMessage(&discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: characterPageButtons(c.Locale, playerInfo.ID, maplestory.PageBasic),
+	})
+}
+
+// handleCharacterPage handles the /캐릭터 pagination buttons
+func (b *Bot) handleCharacterPage(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, args string) {
+	ocid, pageStr, ok := strings.Cut(args, ":")
+	page, err := strconv.Atoi(pageStr)
+	if !ok || err != nil {
+		slog.WarnContext(ctx, "Malformed character page button", "args", args)
+		return
+	}
+
+	// Acknowledge the click; the message is edited once the page is loaded
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+
+	l := b.locale(i)
+	ctx, cancel := context.WithTimeout(i18n.WithLocale(ctx, l), 15*time.Second)
+	defer cancel()
+
+	embed, err := b.maplestory.CreateCharacterEmbed(ctx, ocid, page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to create character embed", "ocid", ocid, "page", page, "error", err)
+		return
+	}
+
+	components := characterPageButtons(l, ocid, page)
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+}
+
+// characterPageButtons builds the previous/next buttons for a character page
+func characterPageButtons(l i18n.Locale, ocid string, page int) []discordgo.MessageComponent {
+	prev := (page + maplestory.CharacterPageCount - 1) % maplestory.CharacterPageCount
+	next := (page + 1) % maplestory.CharacterPageCount
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    l.T("button.previous"),
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s:%s:%d", characterPagePrefix, ocid, prev),
+				},
+				discordgo.Button{
+					Label:    l.T("button.next"),
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("%s:%s:%d", characterPagePrefix, ocid, next),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,289 @@
+package bot
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// mentionEvents are the events mention rules can be set for, in display order
+var mentionEvents = []string{
+	storage.MentionPromotion,
+	string(game.EventRankChanged),
+	string(game.EventPentakill),
+	string(game.EventLevelUp),
+	string(game.EventAchievementUnlock),
+	string(game.EventNewGame),
+	string(game.EventMatchCompleted),
+}
+
+// mentionCommands returns the /내플레이어 and /멘션 commands
+func (b *Bot) mentionCommands() []Command {
+	eventNames := map[string]string{
+		storage.MentionPromotion:            "랭크 승급",
+		string(game.EventRankChanged):       "랭크 변동 (승급/강등)",
+		string(game.EventPentakill):         "펜타킬",
+		string(game.EventLevelUp):           "레벨 업",
+		string(game.EventAchievementUnlock): "업적 달성",
+		string(game.EventNewGame):           "새 게임",
+		string(game.EventMatchCompleted):    "모든 경기",
+	}
+	eventChoices := make([]*discordgo.ApplicationCommandOptionChoice, len(mentionEvents))
+	for i, event := range mentionEvents {
+		eventChoices[i] = &discordgo.ApplicationCommandOptionChoice{Name: eventNames[event], Value: event}
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "내플레이어",
+				Description: "추적 중인 플레이어를 내 계정으로 연결합니다 (멘션 규칙에서 본인을 호출)",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "연결",
+						Description: "이 플레이어가 나라고 표시합니다",
+						Options:     b.playerCommandOptions("게임 (예: lol)"),
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "해제",
+						Description: "플레이어와 내 계정의 연결을 해제합니다",
+						Options:     b.playerCommandOptions("게임 (예: lol)"),
+					},
+				},
+			},
+			Run: b.handlePlayerLink,
+		}),
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:                     "멘션",
+				Description:              "특정 이벤트 알림에서 역할이나 멤버를 호출하는 규칙을 관리합니다",
+				DefaultMemberPermissions: &manageGuildPermission,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "추가",
+						Description: "멘션 규칙 추가 (역할과 멤버를 비우면 플레이어와 연결된 본인을 호출)",
+						Options: []*discordgo.ApplicationCommandOption{
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "이벤트",
+								Description: "호출할 이벤트",
+								Required:    true,
+								Choices:     eventChoices,
+							},
+							{
+								Type:        discordgo.ApplicationCommandOptionRole,
+								Name:        "역할",
+								Description: "호출할 역할",
+							},
+							{
+								Type:        discordgo.ApplicationCommandOptionUser,
+								Name:        "멤버",
+								Description: "호출할 멤버",
+							},
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "게임",
+								Description: "이 게임에만 적용합니다 (기본: 모든 게임)",
+								Choices:     b.buildGameChoices(),
+							},
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "목록",
+						Description: "이 서버의 멘션 규칙 목록",
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "삭제",
+						Description: "멘션 규칙을 삭제합니다",
+						Options: []*discordgo.ApplicationCommandOption{
+							{
+								Type:        discordgo.ApplicationCommandOptionInteger,
+								Name:        "번호",
+								Description: "/멘션 목록의 규칙 번호",
+								Required:    true,
+								MinValue:    floatPtr(1),
+							},
+						},
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission)},
+			Run:        b.handleMentionRules,
+		}),
+	}
+}
+
+// handlePlayerLink handles the /내플레이어 command
+func (b *Bot) handlePlayerLink(c *Context) error {
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
+	}
+	name := gameName(l, tracker.Type(), tracker.Name())
+
+	summoner, err := b.repo.GetSummonerByRiotIDAndGame(opts.Player, opts.Game)
+	if err != nil {
+		return i18n.Errorf("link.not_tracked", opts.Player, name)
+	}
+
+	if c.Subcommand() == "해제" {
+		removed, err := b.repo.UnlinkPlayer(summoner.ID, c.UserID())
+		if err != nil {
+			slog.ErrorContext(c.Context(), "Failed to unlink player", "error", err)
+			return i18n.Errorf("link.failed")
+		}
+		if !removed {
+			return i18n.Errorf("link.not_linked", summoner.RiotID)
+		}
+		return c.Reply(l.T("link.removed", summoner.RiotID))
+	}
+
+	// Someone else's claim stands; a verifiable account can be claimed with /연동
+	link, err := b.repo.GetPlayerLink(summoner.ID)
+	switch {
+	case err == nil && link.UserID == c.UserID():
+		return c.Reply(l.T("link.success", summoner.RiotID, name))
+	case err == nil:
+		if _, ok := tracker.(game.OwnershipVerifier); ok && !link.Verified {
+			return i18n.Errorf("link.taken_verify", summoner.RiotID)
+		}
+		return i18n.Errorf("link.taken", summoner.RiotID)
+	case !errors.Is(err, sql.ErrNoRows):
+		slog.ErrorContext(c.Context(), "Failed to get player link", "error", err)
+		return i18n.Errorf("link.failed")
+	}
+
+	if err := b.repo.LinkPlayer(&storage.PlayerLink{SummonerID: summoner.ID, UserID: c.UserID()}); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to link player", "error", err)
+		return i18n.Errorf("link.failed")
+	}
+	return c.Reply(l.T("link.success", summoner.RiotID, name))
+}
+
+// handleMentionRules handles the /멘션 command
+func (b *Bot) handleMentionRules(c *Context) error {
+	var opts struct {
+		Event  string          `option:"이벤트"`
+		Role   *discordgo.Role `option:"역할"`
+		Member *discordgo.User `option:"멤버"`
+		Game   string          `option:"게임"`
+		Number int64           `option:"번호"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+	guildID := c.Interaction.GuildID
+
+	switch c.Subcommand() {
+	case "목록":
+		return b.handleMentionList(c)
+	case "삭제":
+		rules, err := b.repo.GetMentionRules(guildID)
+		if err != nil {
+			slog.ErrorContext(c.Context(), "Failed to get mention rules", "error", err)
+			return i18n.Errorf("mention.failed")
+		}
+		if opts.Number < 1 || int(opts.Number) > len(rules) {
+			return i18n.Errorf("mention.no_rule", opts.Number)
+		}
+		rule := rules[opts.Number-1]
+		if _, err := b.repo.DeleteMentionRule(guildID, rule.ID); err != nil {
+			slog.ErrorContext(c.Context(), "Failed to delete mention rule", "error", err)
+			return i18n.Errorf("mention.failed")
+		}
+		return c.Reply(l.T("mention.deleted", b.describeMentionRule(l, rule)))
+	}
+
+	if opts.Role != nil && opts.Member != nil {
+		return i18n.Errorf("mention.role_or_member")
+	}
+
+	rule := &storage.MentionRule{
+		GuildID:     guildID,
+		GameType:    opts.Game,
+		Event:       opts.Event,
+		MentionType: storage.MentionOwner,
+	}
+	switch {
+	case opts.Role != nil:
+		rule.MentionType, rule.MentionID = storage.MentionRole, opts.Role.ID
+	case opts.Member != nil:
+		rule.MentionType, rule.MentionID = storage.MentionUser, opts.Member.ID
+	}
+
+	if err := b.repo.CreateMentionRule(rule); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to create mention rule", "error", err)
+		return i18n.Errorf("mention.failed")
+	}
+	return c.Reply(l.T("mention.added", b.describeMentionRule(l, rule)))
+}
+
+// handleMentionList lists the mention rules of the guild
+func (b *Bot) handleMentionList(c *Context) error {
+	l := c.Locale
+
+	rules, err := b.repo.GetMentionRules(c.Interaction.GuildID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get mention rules", "error", err)
+		return i18n.Errorf("mention.failed")
+	}
+	if len(rules) == 0 {
+		return c.Reply(l.T("mention.empty"))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(l.T("mention.title"))
+	sb.WriteString("\n")
+	for idx, rule := range rules {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", idx+1, b.describeMentionRule(l, rule)))
+	}
+	return c.Reply(sb.String())
+}
+
+// describeMentionRule formats a rule for display, e.g. "[리그 오브 레전드] 펜타킬 → @역할"
+func (b *Bot) describeMentionRule(l i18n.Locale, rule *storage.MentionRule) string {
+	games := l.T("mention.all_games")
+	if rule.GameType != "" {
+		games = rule.GameType
+		if tracker, err := b.registry.Get(game.GameType(rule.GameType)); err == nil {
+			games = gameName(l, tracker.Type(), tracker.Name())
+		}
+	}
+
+	event, ok := l.Lookup("mention.event." + rule.Event)
+	if !ok {
+		event = rule.Event
+	}
+
+	var who string
+	switch rule.MentionType {
+	case storage.MentionRole:
+		who = fmt.Sprintf("<@&%s>", rule.MentionID)
+	case storage.MentionUser:
+		who = fmt.Sprintf("<@%s>", rule.MentionID)
+	default:
+		who = l.T("mention.owner")
+	}
+
+	return fmt.Sprintf("[%s] %s → %s", games, event, who)
+}
@@ -0,0 +1,217 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// notificationButtonPrefix is the custom ID prefix of notification buttons
+const notificationButtonPrefix = "nb"
+
+// Notification button actions
+const (
+	actionScoreboard = "sb" // args: summoner ID, match ID
+	actionRecent     = "rc" // args: summoner ID
+	actionMute       = "mu" // args: summoner ID
+	actionUnmute     = "um" // args: summoner ID
+)
+
+// recentMatchCount is how many matches the "최근 10경기" button shows
+const recentMatchCount = 10
+
+// notificationComponents builds the buttons attached to a notification in a
+// guild, or in a user's DMs when guildID is a user ID, labelled in locale l
+// Custom IDs are signed for the guild or user they are delivered to
+func (b *Bot) notificationComponents(guildID string, l i18n.Locale, summoner *storage.Summoner, tracker game.Tracker, change game.StateChange) []discordgo.MessageComponent {
+	id := strconv.FormatInt(summoner.ID, 10)
+
+	var buttons []discordgo.MessageComponent
+	if details, ok := tracker.(game.MatchDetailProvider); ok {
+		if matchID := details.StateMatchID(change.Current); matchID != "" && change.HasEvent(game.EventMatchCompleted) {
+			buttons = append(buttons, discordgo.Button{
+				Label:    l.T("button.scoreboard"),
+				Style:    discordgo.PrimaryButton,
+				CustomID: b.signer.sign(guildID, notificationButtonPrefix, actionScoreboard, id, matchID),
+			})
+		}
+	}
+	if game.Supports[game.HistoryProvider](tracker) {
+		buttons = append(buttons, discordgo.Button{
+			Label:    l.T("button.recent", recentMatchCount),
+			Style:    discordgo.SecondaryButton,
+			CustomID: b.signer.sign(guildID, notificationButtonPrefix, actionRecent, id),
+		})
+	}
+	buttons = append(buttons, muteButton(l, b.signer, guildID, id, true))
+	if linker, ok := tracker.(game.ProfileLinker); ok {
+		buttons = append(buttons, discordgo.Button{
+			Label: l.T("button.profile"),
+			Style: discordgo.LinkButton,
+			URL:   linker.ProfileURL(summoner.PUUID, summoner.RiotID),
+		})
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: buttons},
+	}
+}
+
+// muteButton returns the button that mutes, or unmutes, a player in a guild
+func muteButton(l i18n.Locale, signer *customIDSigner, guildID, summonerID string, mute bool) discordgo.Button {
+	if mute {
+		return discordgo.Button{
+			Label:    l.T("button.mute"),
+			Style:    discordgo.SecondaryButton,
+			CustomID: signer.sign(guildID, notificationButtonPrefix, actionMute, summonerID),
+		}
+	}
+	return discordgo.Button{
+		Label:    l.T("button.unmute"),
+		Style:    discordgo.SuccessButton,
+		CustomID: signer.sign(guildID, notificationButtonPrefix, actionUnmute, summonerID),
+	}
+}
+
+// handleNotificationButton handles the buttons on notifications
+func (b *Bot) handleNotificationButton(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate, args string) {
+	l := b.locale(i)
+	_, targetID := subscriptionTarget(i)
+	parts, ok := b.signer.verify(targetID, notificationButtonPrefix, args)
+	if !ok || len(parts) < 2 {
+		slog.WarnContext(ctx, "Invalid notification button signature", "guildID", i.GuildID, "args", args)
+		respondEphemeral(s, i, l.T("button.invalid"))
+		return
+	}
+
+	summonerID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		respondEphemeral(s, i, l.T("button.invalid"))
+		return
+	}
+	summoner, err := b.repo.GetSummonerByID(summonerID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(ctx, "Failed to get summoner", "summonerID", summonerID, "error", err)
+		}
+		respondEphemeral(s, i, l.T("button.untracked"))
+		return
+	}
+
+	switch parts[0] {
+	case actionScoreboard:
+		if len(parts) != 3 {
+			respondEphemeral(s, i, l.T("button.invalid"))
+			return
+		}
+		respondDetail(ctx, b, s, i, summoner, func(ctx context.Context, details game.MatchDetailProvider) (*discordgo.MessageEmbed, error) {
+			return details.Scoreboard(ctx, parts[2])
+		})
+	case actionRecent:
+		respondDetail(ctx, b, s, i, summoner, func(ctx context.Context, history game.HistoryProvider) (*discordgo.MessageEmbed, error) {
+			return history.RecentMatches(ctx, summoner.PUUID, summoner.RiotID, recentMatchCount)
+		})
+	case actionMute:
+		b.setMuted(s, i, summoner, true)
+	case actionUnmute:
+		b.setMuted(s, i, summoner, false)
+	default:
+		slog.WarnContext(ctx, "Unknown notification button action", "action", parts[0])
+	}
+}
+
+// respondDetail replies privately with an embed fetched from the capability C
+// of the player's tracker
+func respondDetail[C any](ctx context.Context, b *Bot, s *discordgo.Session, i *discordgo.InteractionCreate, summoner *storage.Summoner,
+	fetch func(ctx context.Context, provider C) (*discordgo.MessageEmbed, error)) {
+	l := b.locale(i)
+	tracker, err := b.registry.Get(game.GameType(summoner.GameType))
+	if err != nil {
+		respondEphemeral(s, i, l.T("button.game_disabled"))
+		return
+	}
+	provider, ok := tracker.(C)
+	if !ok {
+		respondEphemeral(s, i, l.T("button.no_details"))
+		return
+	}
+
+	// Fetching matches can take a while; defer privately
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(i18n.WithLocale(ctx, l), 30*time.Second)
+	defer cancel()
+
+	embed, err := fetch(ctx, provider)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get match details", "summoner", summoner.RiotID, "error", err)
+		b.editResponse(s, i, l.T("button.details_failed", i18n.Message(l, err)))
+		return
+	}
+
+	s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+}
+
+// setMuted mutes or unmutes a player's notifications in the guild, or in
+// the user's DMs
+// In guilds only server managers, the member who registered the player and
+// its verified owner may do so
+func (b *Bot) setMuted(s *discordgo.Session, i *discordgo.InteractionCreate, summoner *storage.Summoner, muted bool) {
+	l := b.locale(i)
+	targetType, targetID := subscriptionTarget(i)
+	sub, err := b.repo.GetSubscription(summoner.ID, targetID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to get subscription", "summoner", summoner.RiotID, "target", targetID, "error", err)
+		}
+		respondEphemeral(s, i, l.T("button.not_subscribed"))
+		return
+	}
+	userID := interactionUserID(i)
+	if targetType == storage.TargetGuild && i.Member.Permissions&discordgo.PermissionManageGuild == 0 &&
+		userID != sub.RegisteredBy && !b.isVerifiedOwner(summoner.ID, userID) {
+		respondEphemeral(s, i, l.T("button.mute_forbidden"))
+		return
+	}
+
+	if _, err := b.repo.SetSubscriptionMuted(summoner.ID, targetID, muted); err != nil {
+		slog.Error("Failed to update mute", "summoner", summoner.RiotID, "target", targetID, "error", err)
+		respondEphemeral(s, i, l.T("button.mute_failed"))
+		return
+	}
+	slog.Info("Notification mute changed", "summoner", summoner.RiotID, "target", targetID, "muted", muted, "by", userID)
+
+	content := l.T("button.muted", summoner.RiotID)
+	if !muted {
+		content = l.T("button.unmuted", summoner.RiotID)
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						muteButton(l, b.signer, targetID, strconv.FormatInt(summoner.ID, 10), !muted),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// profileRecentMatches is how many recorded matches the recent form line covers
+const profileRecentMatches = 10
+
+// profileMaxAccounts caps the accounts shown, within Discord's embed field limit
+const profileMaxAccounts = 25
+
+// profileCommands returns the /프로필 command
+func (b *Bot) profileCommands() []Command {
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "프로필",
+				Description: "멤버와 연결된 게임 계정을 한눈에 보여줍니다",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionUser,
+						Name:        "멤버",
+						Description: "프로필을 볼 멤버 (기본: 나)",
+					},
+				},
+			},
+			Middleware: []Middleware{cooldown(5 * time.Second), deferReply(false)},
+			Run:        b.handleProfile,
+		}),
+	}
+}
+
+// handleProfile handles the /프로필 command
+func (b *Bot) handleProfile(c *Context) error {
+	var opts struct {
+		Member *discordgo.User `option:"멤버"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+
+	user := opts.Member
+	if user == nil {
+		if c.Interaction.Member != nil {
+			user = c.Interaction.Member.User
+		} else {
+			user = c.Interaction.User
+		}
+	}
+
+	links, err := b.repo.GetPlayerLinksByUser(user.ID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get player links", "userID", user.ID, "error", err)
+		return i18n.Errorf("profile.failed")
+	}
+	if len(links) == 0 {
+		return c.Reply(l.T("profile.empty", user.DisplayName()))
+	}
+	if len(links) > profileMaxAccounts {
+		links = links[:profileMaxAccounts]
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:     l.T("profile.title", user.DisplayName()),
+		Color:     0x5865F2,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: user.AvatarURL("")},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	for _, link := range links {
+		summoner, err := b.repo.GetSummonerByID(link.SummonerID)
+		if err != nil {
+			slog.WarnContext(c.Context(), "Linked player not found", "summonerID", link.SummonerID, "error", err)
+			continue
+		}
+		embed.Fields = append(embed.Fields, b.profileField(c.Context(), l, summoner, link))
+	}
+	if len(embed.Fields) == 0 {
+		return c.Reply(l.T("profile.empty", user.DisplayName()))
+	}
+
+	return c.ReplyEmbeds(embed)
+}
+
+// profileField builds the embed section of one linked account: what its
+// tracker reports about the player, then its recent recorded form
+func (b *Bot) profileField(ctx context.Context, l i18n.Locale, summoner *storage.Summoner, link *storage.PlayerLink) *discordgo.MessageEmbedField {
+	tracker, err := b.registry.Get(game.GameType(summoner.GameType))
+	gameLabel := summoner.GameType
+	if err == nil {
+		gameLabel = gameName(l, tracker.Type(), tracker.Name())
+	}
+	name := fmt.Sprintf("%s · `%s`", gameLabel, summoner.RiotID)
+	if link.Verified {
+		name += " ✅"
+	}
+
+	var lines []string
+	if tracker != nil {
+		if provider, ok := tracker.(game.ProfileProvider); ok {
+			providerCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			profile, err := provider.PlayerProfile(providerCtx, summoner.PUUID, summoner.RiotID)
+			cancel()
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to get player profile", "summoner", summoner.RiotID, "error", err)
+				lines = append(lines, l.T("profile.unavailable"))
+			} else if profile != "" {
+				lines = append(lines, profile)
+			}
+		}
+	}
+
+	matches, err := b.repo.GetRecentMatchResults(summoner.ID, profileRecentMatches)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get recent matches", "summoner", summoner.RiotID, "error", err)
+	}
+	if len(matches) > 0 {
+		var wins int
+		var kda float64
+		for _, m := range matches {
+			if m.Win {
+				wins++
+			}
+			kda += m.KDA()
+		}
+		lines = append(lines, l.T("profile.recent", len(matches), wins, len(matches)-wins, kda/float64(len(matches))))
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, l.T("profile.no_details"))
+	}
+	return &discordgo.MessageEmbedField{Name: name, Value: strings.Join(lines, "\n")}
+}
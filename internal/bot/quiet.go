@@ -0,0 +1,185 @@
+package bot
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// quietHoursCommands returns the /방해금지 and /알림묶음 commands
+func (b *Bot) quietHoursCommands() []Command {
+	hour := func(name, description string) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        name,
+			Description: description,
+			Required:    true,
+			MinValue:    floatPtr(0),
+			MaxValue:    23,
+		}
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "방해금지",
+				Description: "매일 정해진 시간에 알림을 멈춥니다 (서버에서는 서버 알림, DM에서는 개인 알림)",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "설정",
+						Description: "방해금지 시간 설정",
+						Options: []*discordgo.ApplicationCommandOption{
+							hour("시작", "시작 시각 (0~23시)"),
+							hour("종료", "종료 시각 (0~23시)"),
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "시간대",
+								Description: "IANA 시간대 (기본: Asia/Seoul)",
+							},
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "모드",
+								Description: "서버 알림 처리 방식 (기본: 요약)",
+								Choices: []*discordgo.ApplicationCommandOptionChoice{
+									{Name: "끝난 뒤 요약으로 보내기", Value: string(storage.QuietDigest)},
+									{Name: "보내지 않기", Value: string(storage.QuietDrop)},
+								},
+							},
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "해제",
+						Description: "방해금지 시간 해제",
+					},
+				},
+			},
+			Run: b.handleQuietHours,
+		}),
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:                     "알림묶음",
+				Description:              "1분 안에 알림이 몰리면 나머지를 하나의 요약으로 묶습니다",
+				DefaultMemberPermissions: &manageGuildPermission,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "개수",
+						Description: "1분에 따로 보낼 최대 알림 수 (0: 묶지 않음)",
+						Required:    true,
+						MinValue:    floatPtr(0),
+						MaxValue:    50,
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission)},
+			Run:        b.handleBurstLimit,
+		}),
+	}
+}
+
+// handleQuietHours handles the /방해금지 command
+// In a guild it sets the guild's quiet hours (server managers only); in DMs
+// the invoking user's, for personal notifications
+func (b *Bot) handleQuietHours(c *Context) error {
+	var opts struct {
+		Start    int64  `option:"시작"`
+		End      int64  `option:"종료"`
+		Timezone string `option:"시간대"`
+		Mode     string `option:"모드"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+
+	targetType, targetID := c.Target()
+	guild := targetType == storage.TargetGuild
+	if guild && c.Interaction.Member.Permissions&manageGuildPermission == 0 {
+		return i18n.Errorf("error.forbidden")
+	}
+
+	// Current settings, for the default time zone and mode
+	var current storage.QuietHours
+	mode := storage.QuietDigest
+	if guild {
+		settings, err := b.repo.GetGuildSettings(targetID)
+		if err != nil {
+			settings = storage.DefaultGuildSettings(targetID)
+		}
+		current, mode = settings.QuietHours, settings.QuietMode
+	} else {
+		settings, err := b.repo.GetUserSettings(targetID)
+		if err != nil {
+			slog.ErrorContext(c.Context(), "Failed to get user settings", "error", err)
+			return i18n.Errorf("quiet.failed")
+		}
+		current = settings.QuietHours
+	}
+
+	quiet := storage.QuietHours{Start: int(opts.Start), End: int(opts.End), Timezone: strings.TrimSpace(opts.Timezone)}
+	if c.Subcommand() == "해제" {
+		quiet = storage.QuietHours{Timezone: current.Timezone}
+	} else {
+		if quiet.Timezone == "" {
+			quiet.Timezone = current.Timezone
+		}
+		if _, err := time.LoadLocation(quiet.Timezone); err != nil || quiet.Timezone == "Local" {
+			return i18n.Errorf("quiet.invalid_timezone", quiet.Timezone)
+		}
+		if !quiet.Enabled() {
+			return i18n.Errorf("quiet.same_hours")
+		}
+		if opts.Mode != "" {
+			mode = storage.QuietMode(opts.Mode)
+		}
+	}
+
+	var err error
+	if guild {
+		err = b.repo.SetGuildQuietHours(targetID, quiet, mode)
+	} else {
+		err = b.repo.UpsertUserSettings(&storage.UserSettings{UserID: targetID, QuietHours: quiet})
+	}
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save quiet hours", "target", targetID, "error", err)
+		return i18n.Errorf("quiet.failed")
+	}
+
+	switch {
+	case !quiet.Enabled() && guild:
+		return c.Reply(l.T("quiet.off_guild"))
+	case !quiet.Enabled():
+		return c.Reply(l.T("quiet.off"))
+	case guild:
+		return c.Reply(l.T("quiet.set_guild", quiet.Start, quiet.End, quiet.Timezone, l.T("quiet.mode."+string(mode))))
+	default:
+		return c.Reply(l.T("quiet.set", quiet.Start, quiet.End, quiet.Timezone))
+	}
+}
+
+// handleBurstLimit handles the /알림묶음 command
+func (b *Bot) handleBurstLimit(c *Context) error {
+	var opts struct {
+		Limit int64 `option:"개수"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	if err := b.repo.SetGuildBurstLimit(c.Interaction.GuildID, int(opts.Limit)); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save burst limit", "error", err)
+		return i18n.Errorf("burst.failed")
+	}
+
+	if opts.Limit == 0 {
+		return c.Reply(c.Locale.T("burst.off"))
+	}
+	return c.Reply(c.Locale.T("burst.set", opts.Limit))
+}
@@ -0,0 +1,315 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+	"github.com/flor3z/discord-bot/internal/report"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// reportCheckInterval is how often the scheduler looks for due recaps
+const reportCheckInterval = time.Minute
+
+// weekdayNames are the Korean names of the days of the week
+var weekdayNames = []string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"}
+
+// reportCommands returns the recap report commands
+func (b *Bot) reportCommands() []Command {
+	weekdayChoices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(weekdayNames))
+	for _, day := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday} {
+		weekdayChoices = append(weekdayChoices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  weekdayNames[day],
+			Value: int(day),
+		})
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "리포트",
+				Description: "등록된 플레이어들의 주간/월간 활동 요약을 보여줍니다",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "기간",
+						Description: "요약 기간 (기본: 주간)",
+						Required:    false,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "주간 (최근 7일)", Value: string(report.PeriodWeekly)},
+							{Name: "월간 (최근 30일)", Value: string(report.PeriodMonthly)},
+						},
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild, deferReply(false)},
+			Run:        b.handleReport,
+		}),
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:                     "리포트설정",
+				Description:              "알림 채널로 보내는 정기 리포트의 요일, 시간, 시간대를 설정합니다",
+				DefaultMemberPermissions: &manageGuildPermission,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "요일",
+						Description: "주간 리포트 요일",
+						Required:    false,
+						Choices:     weekdayChoices,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "시",
+						Description: "보낼 시각 (0-23시)",
+						Required:    false,
+						MinValue:    floatPtr(0),
+						MaxValue:    23,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "분",
+						Description: "보낼 시각 (0-59분)",
+						Required:    false,
+						MinValue:    floatPtr(0),
+						MaxValue:    59,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "시간대",
+						Description: "IANA 시간대 (예: Asia/Seoul, America/Los_Angeles)",
+						Required:    false,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "주간",
+						Description: "주간 리포트 사용",
+						Required:    false,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "월간",
+						Description: "월간 리포트 사용 (매월 1일)",
+						Required:    false,
+					},
+				},
+			},
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission)},
+			Run:        b.handleReportSettings,
+		}),
+	}
+}
+
+// handleReport handles the /리포트 command
+func (b *Bot) handleReport(c *Context) error {
+	opts := struct {
+		Period string `option:"기간"`
+	}{Period: string(report.PeriodWeekly)}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	period := report.Period(opts.Period)
+	guildID := c.Interaction.GuildID
+
+	sched, err := b.repo.GetReportSchedule(guildID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get report schedule", "error", err)
+		return i18n.Errorf("report.failed")
+	}
+
+	// Whole days ending today in the guild's timezone
+	now := time.Now().In(scheduleLocation(sched))
+	days := 7
+	if period == report.PeriodMonthly {
+		days = 30
+	}
+	to := startOfDay(now).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -days)
+
+	rep, err := report.Build(b.repo, b.registry, guildID, period, from, to)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to build report", "guildID", guildID, "error", err)
+		return i18n.Errorf("report.failed")
+	}
+
+	return c.ReplyEmbeds(rep.Embed(c.Locale))
+}
+
+// handleReportSettings handles the /리포트설정 command
+func (b *Bot) handleReportSettings(c *Context) error {
+	var opts struct {
+		Weekday  *int64  `option:"요일"`
+		Hour     *int64  `option:"시"`
+		Minute   *int64  `option:"분"`
+		Timezone *string `option:"시간대"`
+		Weekly   *bool   `option:"주간"`
+		Monthly  *bool   `option:"월간"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+
+	sched, err := b.repo.GetReportSchedule(c.Interaction.GuildID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get report schedule", "error", err)
+		return i18n.Errorf("report.settings_unavailable")
+	}
+
+	if opts.Weekday != nil {
+		sched.Weekday = time.Weekday(*opts.Weekday)
+	}
+	if opts.Hour != nil {
+		sched.Hour = int(*opts.Hour)
+	}
+	if opts.Minute != nil {
+		sched.Minute = int(*opts.Minute)
+	}
+	if opts.Timezone != nil {
+		tz := strings.TrimSpace(*opts.Timezone)
+		if _, err := time.LoadLocation(tz); err != nil || tz == "" || tz == "Local" {
+			return i18n.Errorf("report.invalid_timezone", tz)
+		}
+		sched.Timezone = tz
+	}
+	if opts.Weekly != nil {
+		sched.Weekly = *opts.Weekly
+	}
+	if opts.Monthly != nil {
+		sched.Monthly = *opts.Monthly
+	}
+
+	if err := b.repo.UpsertReportSchedule(sched); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to save report schedule", "error", err)
+		return i18n.Errorf("report.settings_failed")
+	}
+
+	return c.ReplyEphemeral(describeSchedule(c.Locale, sched))
+}
+
+// describeSchedule formats a report schedule for display
+func describeSchedule(l i18n.Locale, sched *storage.ReportSchedule) string {
+	at := fmt.Sprintf("%02d:%02d (%s)", sched.Hour, sched.Minute, sched.Timezone)
+	var lines []string
+	if sched.Weekly {
+		lines = append(lines, l.T("report.weekly", weekdayName(l, sched.Weekday), at))
+	} else {
+		lines = append(lines, l.T("report.weekly_off"))
+	}
+	if sched.Monthly {
+		lines = append(lines, l.T("report.monthly", at))
+	} else {
+		lines = append(lines, l.T("report.monthly_off"))
+	}
+	lines = append(lines, l.T("report.channel_hint"))
+	return strings.Join(lines, "\n")
+}
+
+// weekdayName returns the localized name of a day of the week
+func weekdayName(l i18n.Locale, day time.Weekday) string {
+	return l.T("weekday." + strings.ToLower(day.String()))
+}
+
+// runReportLoop sends scheduled recaps until the context is cancelled
+func (b *Bot) runReportLoop(ctx context.Context) {
+	ticker := time.NewTicker(reportCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			b.sendDueReports(now)
+		}
+	}
+}
+
+// sendDueReports sends every weekly or monthly recap whose time has come
+// Each recap is sent once per period; a recap missed while the bot was down
+// is still sent later on the same day
+func (b *Bot) sendDueReports(now time.Time) {
+	guilds, err := b.repo.GetAllGuildSettings()
+	if err != nil {
+		slog.Error("Failed to get guild settings", "error", err)
+		return
+	}
+
+	for _, settings := range guilds {
+		if settings.NotificationChannelID == "" {
+			continue
+		}
+
+		sched, err := b.repo.GetReportSchedule(settings.GuildID)
+		if err != nil {
+			slog.Error("Failed to get report schedule", "guildID", settings.GuildID, "error", err)
+			continue
+		}
+
+		local := now.In(scheduleLocation(sched))
+		today := startOfDay(local)
+		due := today.Add(time.Duration(sched.Hour)*time.Hour + time.Duration(sched.Minute)*time.Minute)
+		if local.Before(due) {
+			continue
+		}
+
+		changed := false
+		year, week := local.ISOWeek()
+		if weekKey := fmt.Sprintf("%d-W%02d", year, week); sched.Weekly && local.Weekday() == sched.Weekday && sched.LastWeekly != weekKey {
+			b.sendReport(settings, report.PeriodWeekly, today.AddDate(0, 0, -7), today)
+			sched.LastWeekly = weekKey
+			changed = true
+		}
+		if monthKey := local.Format("2006-01"); sched.Monthly && local.Day() == 1 && sched.LastMonthly != monthKey {
+			b.sendReport(settings, report.PeriodMonthly, today.AddDate(0, -1, 0), today)
+			sched.LastMonthly = monthKey
+			changed = true
+		}
+
+		if changed {
+			if err := b.repo.UpsertReportSchedule(sched); err != nil {
+				slog.Error("Failed to save report schedule", "guildID", settings.GuildID, "error", err)
+			}
+		}
+	}
+}
+
+// sendReport builds a recap and posts it to the guild's notification channel
+// Guilds with no activity in the period get nothing
+func (b *Bot) sendReport(settings *storage.GuildSettings, period report.Period, from, to time.Time) {
+	rep, err := report.Build(b.repo, b.registry, settings.GuildID, period, from, to)
+	if err != nil {
+		slog.Error("Failed to build report", "guildID", settings.GuildID, "error", err)
+		return
+	}
+	if len(rep.Players) == 0 {
+		slog.Debug("Skipping empty report", "guildID", settings.GuildID, "period", period)
+		return
+	}
+
+	if _, err := b.session.ChannelMessageSendEmbed(settings.NotificationChannelID, rep.Embed(b.guildLocale(settings.GuildID))); err != nil {
+		slog.Error("Failed to send report", "guildID", settings.GuildID, "error", err)
+		return
+	}
+	slog.Info("Sent report", "guildID", settings.GuildID, "period", period, "players", len(rep.Players))
+}
+
+// scheduleLocation returns the timezone of a schedule, falling back to KST
+func scheduleLocation(sched *storage.ReportSchedule) *time.Location {
+	loc, err := time.LoadLocation(sched.Timezone)
+	if err != nil {
+		return nexon.KST
+	}
+	return loc
+}
+
+// startOfDay returns midnight of t's day in t's location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,418 @@
+package bot
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/notify"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// manageGuildPermission restricts commands that handle webhook URLs to server managers
+var manageGuildPermission int64 = discordgo.PermissionManageGuild
+
+// routeCommands returns the notification route commands
+func (b *Bot) routeCommands() []Command {
+	gameOption := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "게임",
+		Description: "이 게임의 알림만 보냅니다 (기본: 모든 게임)",
+		Required:    false,
+		Choices:     b.buildGameChoices(),
+	}
+
+	subcommands := []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "채널",
+			Description: "알림을 봇 메시지로 채널에 보냅니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "채널",
+					Description:  "알림을 보낼 채널",
+					Required:     true,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+				},
+				gameOption,
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "디스코드웹훅",
+			Description: "알림을 디스코드 웹훅으로 보냅니다 (게임별 이름/아바타 지정 가능)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "url",
+					Description: "웹훅 URL (https://discord.com/api/webhooks/...)",
+					Required:    true,
+				},
+				gameOption,
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "이름",
+					Description: "웹훅 표시 이름 (기본: 게임 이름)",
+					Required:    false,
+					MaxLength:   80,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "아바타",
+					Description: "웹훅 아바타 이미지 URL",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "웹훅",
+			Description: "알림을 JSON으로 외부 웹훅에 보냅니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "url",
+					Description: "웹훅 URL (https://...)",
+					Required:    true,
+				},
+				gameOption,
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "슬랙",
+			Description: "알림을 슬랙 수신 웹훅으로 보냅니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "url",
+					Description: "슬랙 웹훅 URL (https://hooks.slack.com/services/...)",
+					Required:    true,
+				},
+				gameOption,
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "목록",
+			Description: "이 서버의 알림 경로 목록",
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "삭제",
+			Description: "알림 경로를 삭제합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "번호",
+					Description: "/알림경로 목록의 경로 번호",
+					Required:    true,
+					MinValue:    floatPtr(1),
+				},
+			},
+		},
+	}
+
+	// Telegram needs a bot token to send messages
+	if b.telegram != nil {
+		subcommands = append(subcommands, &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "텔레그램",
+			Description: "알림을 텔레그램 채팅으로 보냅니다 (봇을 채팅에 먼저 초대하세요)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "채팅",
+					Description: "채팅 ID (예: -1001234567890) 또는 @채널이름",
+					Required:    true,
+				},
+				gameOption,
+			},
+		})
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:                     "알림경로",
+				Description:              "알림을 보낼 채널과 웹훅을 관리합니다 (경로가 없으면 /채널설정 채널로 전송)",
+				DefaultMemberPermissions: &manageGuildPermission,
+				Options:                  subcommands,
+			},
+			Middleware: []Middleware{requireGuild, requirePermission(manageGuildPermission), deferReply(true)},
+			Run:        b.handleRoutes,
+		}),
+	}
+}
+
+// handleRoutes handles the /알림경로 command
+func (b *Bot) handleRoutes(c *Context) error {
+	var opts struct {
+		Channel *discordgo.Channel `option:"채널"`
+		URL     string             `option:"url"`
+		Game    string             `option:"게임"`
+		Name    string             `option:"이름"`
+		Avatar  string             `option:"아바타"`
+		Chat    string             `option:"채팅"`
+		Number  int64              `option:"번호"`
+	}
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+
+	switch c.Subcommand() {
+	case "목록":
+		return b.handleRouteList(c)
+	case "삭제":
+		return b.handleRouteDelete(c, opts.Number)
+	}
+
+	route := &storage.NotificationRoute{GuildID: c.Interaction.GuildID, GameType: opts.Game}
+	switch c.Subcommand() {
+	case "채널":
+		route.Sink = string(notify.KindChannel)
+		route.Target = opts.Channel.ID
+	case "디스코드웹훅":
+		route.Sink = string(notify.KindDiscordWebhook)
+		route.Target = strings.TrimSpace(opts.URL)
+		if !isDiscordWebhookURL(route.Target) {
+			return i18n.Errorf("route.invalid_discord_webhook")
+		}
+		route.Username = opts.Name
+		route.AvatarURL = strings.TrimSpace(opts.Avatar)
+		if route.AvatarURL != "" && !isHTTPSURL(route.AvatarURL) {
+			return i18n.Errorf("route.invalid_avatar")
+		}
+	case "웹훅":
+		route.Sink = string(notify.KindWebhook)
+		route.Target = strings.TrimSpace(opts.URL)
+		if !isHTTPSURL(route.Target) {
+			return i18n.Errorf("route.invalid_webhook")
+		}
+		if err := notify.CheckWebhookURL(c.Context(), route.Target); err != nil {
+			slog.WarnContext(c.Context(), "Rejected webhook URL", "url", route.Target, "error", err)
+			return i18n.Errorf("route.private_webhook")
+		}
+	case "슬랙":
+		route.Sink = string(notify.KindSlack)
+		route.Target = strings.TrimSpace(opts.URL)
+		if !isSlackWebhookURL(route.Target) {
+			return i18n.Errorf("route.invalid_slack")
+		}
+	case "텔레그램":
+		route.Sink = string(notify.KindTelegram)
+		route.Target = strings.TrimSpace(opts.Chat)
+		if !telegramChatPattern.MatchString(route.Target) {
+			return i18n.Errorf("route.invalid_telegram")
+		}
+		// The bot token is shared by every guild, so a guild must show it
+		// can post in the chat before its notifications go there
+		if claimed, err := b.claimTelegramChat(c, route.Target); err != nil || !claimed {
+			return err
+		}
+	}
+
+	if err := b.repo.CreateNotificationRoute(route); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to create notification route", "error", err)
+		return i18n.Errorf("route.failed")
+	}
+
+	return c.Reply(l.T("route.added", b.describeRoute(l, route)))
+}
+
+// claimTelegramChat reports whether the guild posted its one-time code in a
+// Telegram chat. Without a pending code a new one is issued and explained
+func (b *Bot) claimTelegramChat(c *Context, chat string) (bool, error) {
+	l := c.Locale
+	guildID := c.Interaction.GuildID
+
+	code := b.telegramClaims.get(guildID, chat)
+	if code == "" {
+		code = b.telegramClaims.issue(guildID, chat)
+		return false, c.Reply(l.T("route.telegram_claim", chat, int(challengeTTL.Minutes()), code))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
+	defer cancel()
+
+	posted, err := b.telegram.ChatPosted(ctx, chat, code)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read Telegram updates", "error", err)
+		return false, i18n.Errorf("route.telegram_unavailable")
+	}
+	if !posted {
+		return false, i18n.Errorf("route.telegram_not_posted", code, chat)
+	}
+	b.telegramClaims.remove(guildID, chat)
+	return true, nil
+}
+
+// telegramClaimStore keeps the pending Telegram chat codes of each guild
+type telegramClaimStore struct {
+	mu      sync.Mutex
+	pending map[string]telegramClaim // keyed by guild ID and chat
+}
+
+// telegramClaim is a code a guild must post in a Telegram chat
+type telegramClaim struct {
+	code    string
+	expires time.Time
+}
+
+// issue creates a code for a guild and chat, dropping expired ones
+func (s *telegramClaimStore) issue(guildID, chat string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == nil {
+		s.pending = make(map[string]telegramClaim)
+	}
+	now := time.Now()
+	for key, claim := range s.pending {
+		if now.After(claim.expires) {
+			delete(s.pending, key)
+		}
+	}
+
+	// A bot command, so that groups in privacy mode still show it to the bot
+	code := "/verify_" + strings.ToLower(rand.Text()[:10])
+	s.pending[guildID+"|"+chat] = telegramClaim{code: code, expires: now.Add(challengeTTL)}
+	return code
+}
+
+// get returns the unexpired code of a guild and chat, or ""
+func (s *telegramClaimStore) get(guildID, chat string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claim, ok := s.pending[guildID+"|"+chat]
+	if !ok || time.Now().After(claim.expires) {
+		return ""
+	}
+	return claim.code
+}
+
+// remove drops the code of a guild and chat
+func (s *telegramClaimStore) remove(guildID, chat string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, guildID+"|"+chat)
+}
+
+// handleRouteList lists the notification routes of the guild
+func (b *Bot) handleRouteList(c *Context) error {
+	l := c.Locale
+	routes, err := b.repo.GetNotificationRoutes(c.Interaction.GuildID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get notification routes", "error", err)
+		return i18n.Errorf("route.unavailable")
+	}
+
+	if len(routes) == 0 {
+		return c.Reply(l.T("route.empty"))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(l.T("route.title") + "\n")
+	for idx, route := range routes {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", idx+1, b.describeRoute(l, route)))
+	}
+	sb.WriteString("\n" + l.T("route.fallback_hint"))
+
+	return c.Reply(sb.String())
+}
+
+// handleRouteDelete deletes the n-th route shown by /알림경로 목록
+func (b *Bot) handleRouteDelete(c *Context, n int64) error {
+	guildID := c.Interaction.GuildID
+	routes, err := b.repo.GetNotificationRoutes(guildID)
+	if err != nil {
+		slog.ErrorContext(c.Context(), "Failed to get notification routes", "error", err)
+		return i18n.Errorf("route.unavailable")
+	}
+	if n < 1 || int(n) > len(routes) {
+		return i18n.Errorf("route.no_route", n)
+	}
+
+	route := routes[n-1]
+	if _, err := b.repo.DeleteNotificationRoute(guildID, route.ID); err != nil {
+		slog.ErrorContext(c.Context(), "Failed to delete notification route", "error", err)
+		return i18n.Errorf("route.delete_failed")
+	}
+
+	return c.Reply(c.Locale.T("route.deleted", b.describeRoute(c.Locale, route)))
+}
+
+// describeRoute formats a route for display; webhook URLs are shortened to their host
+func (b *Bot) describeRoute(l i18n.Locale, route *storage.NotificationRoute) string {
+	name := l.T("route.all_games")
+	if route.GameType != "" {
+		name = route.GameType
+		for _, g := range b.registry.List() {
+			if string(g.Type) == route.GameType {
+				name = gameName(l, g.Type, g.Name)
+			}
+		}
+	}
+
+	kind := notify.Kind(route.Sink)
+	target := route.Target
+	if kind == notify.KindChannel {
+		target = fmt.Sprintf("<#%s>", route.Target)
+	} else if kind == notify.KindTelegram {
+		target = fmt.Sprintf("`%s`", route.Target)
+	} else if u, err := url.Parse(route.Target); err == nil {
+		target = fmt.Sprintf("`%s/…`", u.Host)
+	}
+
+	desc := fmt.Sprintf("[%s] %s → %s", name, l.T("route.sink."+string(kind)), target)
+	if route.Username != "" {
+		desc += " " + l.T("route.username", route.Username)
+	}
+	return desc
+}
+
+// isHTTPSURL reports whether s is an absolute https URL
+func isHTTPSURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
+
+// isDiscordWebhookURL reports whether s looks like a Discord incoming webhook URL
+func isDiscordWebhookURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	switch u.Host {
+	case "discord.com", "discordapp.com", "canary.discord.com", "ptb.discord.com":
+	default:
+		return false
+	}
+	return strings.HasPrefix(u.Path, "/api/webhooks/")
+}
+
+// isSlackWebhookURL reports whether s looks like a Slack incoming webhook URL
+func isSlackWebhookURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme == "https" && u.Host == "hooks.slack.com"
+}
+
+// telegramChatPattern matches a numeric Telegram chat ID or a public @channel name
+var telegramChatPattern = regexp.MustCompile(`^(-?\d{1,20}|@[A-Za-z][A-Za-z0-9_]{4,31})$`)
+
+// floatPtr returns a pointer to v, for option bounds
+func floatPtr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,226 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// CommandDiff describes how registered commands differ from the bot's definitions
+type CommandDiff struct {
+	Created   []string
+	Updated   []string
+	Deleted   []string
+	Unchanged []string
+}
+
+// Changed reports whether Discord needs to be updated
+func (d *CommandDiff) Changed() bool {
+	return len(d.Created)+len(d.Updated)+len(d.Deleted) > 0
+}
+
+// String summarizes the diff, e.g. "created [언어], updated [], deleted [], unchanged 12"
+func (d *CommandDiff) String() string {
+	return fmt.Sprintf("created %v, updated %v, deleted %v, unchanged %d", d.Created, d.Updated, d.Deleted, len(d.Unchanged))
+}
+
+// loadCommands returns the localized command definitions and sets up their handlers
+func (b *Bot) loadCommands() []*discordgo.ApplicationCommand {
+	commands := b.getCommands()
+	definitions := make([]*discordgo.ApplicationCommand, 0, len(commands))
+	b.handlers = make(map[string]CommandHandler)
+
+	for _, cmd := range commands {
+		// Commands are server-only unless they opt in to DMs
+		if cmd.Definition.Contexts == nil {
+			cmd.Definition.Contexts = inGuilds()
+		}
+		if missing := i18n.LocalizeCommand(cmd.Definition); len(missing) > 0 {
+			slog.Warn("Missing command translations", "name", cmd.Definition.Name, "keys", missing)
+		}
+		definitions = append(definitions, cmd.Definition)
+		b.handlers[cmd.Definition.Name] = cmd.Handler
+	}
+
+	return definitions
+}
+
+// SyncCommands makes the commands registered in a guild (or globally, if
+// guildID is empty) match the bot's definitions
+// Registered commands are compared first; when they differ, all commands are
+// replaced with a single bulk overwrite, removing stale ones
+func (b *Bot) SyncCommands(guildID string) (*CommandDiff, error) {
+	appID, err := b.applicationID()
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := b.loadCommands()
+	existing, err := b.session.ApplicationCommands(appID, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registered commands: %w", err)
+	}
+
+	diff := diffCommands(existing, definitions)
+	if !diff.Changed() {
+		return diff, nil
+	}
+
+	if _, err := b.session.ApplicationCommandBulkOverwrite(appID, guildID, definitions); err != nil {
+		return nil, fmt.Errorf("failed to overwrite commands: %w", err)
+	}
+	return diff, nil
+}
+
+// ListCommands returns the commands registered in a guild, or globally if guildID is empty
+func (b *Bot) ListCommands(guildID string) ([]*discordgo.ApplicationCommand, error) {
+	appID, err := b.applicationID()
+	if err != nil {
+		return nil, err
+	}
+	return b.session.ApplicationCommands(appID, guildID)
+}
+
+// PurgeCommands removes every command registered in a guild, or globally if guildID is empty
+// Returns the number of removed commands
+func (b *Bot) PurgeCommands(guildID string) (int, error) {
+	existing, err := b.ListCommands(guildID)
+	if err != nil {
+		return 0, err
+	}
+	if len(existing) == 0 {
+		return 0, nil
+	}
+
+	appID, err := b.applicationID()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := b.session.ApplicationCommandBulkOverwrite(appID, guildID, []*discordgo.ApplicationCommand{}); err != nil {
+		return 0, fmt.Errorf("failed to purge commands: %w", err)
+	}
+	return len(existing), nil
+}
+
+// applicationID returns the bot's application ID
+// It comes from DISCORD_APPLICATION_ID, the gateway session or, when the
+// session isn't open (CLI use), the bot user itself
+func (b *Bot) applicationID() (string, error) {
+	if b.config.DiscordApplicationID != "" {
+		return b.config.DiscordApplicationID, nil
+	}
+	if b.session.State != nil && b.session.State.User != nil {
+		return b.session.State.User.ID, nil
+	}
+	user, err := b.session.User("@me")
+	if err != nil {
+		return "", fmt.Errorf("failed to get application ID: %w", err)
+	}
+	return user.ID, nil
+}
+
+// diffCommands compares registered commands with definitions by name
+func diffCommands(existing, definitions []*discordgo.ApplicationCommand) *CommandDiff {
+	registered := make(map[string]*discordgo.ApplicationCommand, len(existing))
+	for _, cmd := range existing {
+		registered[cmd.Name] = cmd
+	}
+
+	diff := &CommandDiff{}
+	for _, def := range definitions {
+		cmd, ok := registered[def.Name]
+		switch {
+		case !ok:
+			diff.Created = append(diff.Created, def.Name)
+		case commandSignature(cmd) != commandSignature(def):
+			diff.Updated = append(diff.Updated, def.Name)
+		default:
+			diff.Unchanged = append(diff.Unchanged, def.Name)
+		}
+		delete(registered, def.Name)
+	}
+	for name := range registered {
+		diff.Deleted = append(diff.Deleted, name)
+	}
+	sort.Strings(diff.Deleted)
+
+	return diff
+}
+
+// commandSignature serializes the parts of a command the bot defines, so a
+// registered command and its definition compare equal when nothing changed
+// Fields Discord fills in (IDs, version, defaults) are left out; a false
+// mismatch only costs an unnecessary overwrite
+func commandSignature(cmd *discordgo.ApplicationCommand) string {
+	signature := struct {
+		Name                     string
+		NameLocalizations        map[discordgo.Locale]string
+		Description              string
+		DescriptionLocalizations map[discordgo.Locale]string
+		DefaultMemberPermissions *int64
+		Contexts                 *[]discordgo.InteractionContextType
+		Options                  []*discordgo.ApplicationCommandOption
+	}{
+		Name:                     cmd.Name,
+		NameLocalizations:        derefLocalizations(cmd.NameLocalizations),
+		Description:              cmd.Description,
+		DescriptionLocalizations: derefLocalizations(cmd.DescriptionLocalizations),
+		DefaultMemberPermissions: cmd.DefaultMemberPermissions,
+		Contexts:                 cmd.Contexts,
+		Options:                  normalizeOptions(cmd.Options),
+	}
+
+	data, err := json.Marshal(signature)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// normalizeOptions copies options with empty collections set to nil
+func normalizeOptions(options []*discordgo.ApplicationCommandOption) []*discordgo.ApplicationCommandOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	normalized := make([]*discordgo.ApplicationCommandOption, len(options))
+	for i, opt := range options {
+		o := *opt
+		o.NameLocalizations = emptyToNil(o.NameLocalizations)
+		o.DescriptionLocalizations = emptyToNil(o.DescriptionLocalizations)
+		if len(o.ChannelTypes) == 0 {
+			o.ChannelTypes = nil
+		}
+		o.Options = normalizeOptions(o.Options)
+
+		o.Choices = nil
+		for _, choice := range opt.Choices {
+			c := *choice
+			c.NameLocalizations = emptyToNil(c.NameLocalizations)
+			c.Value = fmt.Sprint(c.Value) // Discord returns numbers as floats
+			o.Choices = append(o.Choices, &c)
+		}
+		normalized[i] = &o
+	}
+	return normalized
+}
+
+// derefLocalizations returns a command localization map, nil if empty
+func derefLocalizations(m *map[discordgo.Locale]string) map[discordgo.Locale]string {
+	if m == nil {
+		return nil
+	}
+	return emptyToNil(*m)
+}
+
+// emptyToNil returns nil for an empty localization map
+func emptyToNil(m map[discordgo.Locale]string) map[discordgo.Locale]string {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,237 @@
+package bot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// challengeTTL is how long a user has to complete an ownership challenge
+const challengeTTL = 10 * time.Minute
+
+// pendingVerification is an ownership challenge a user was given by /연동 시작
+type pendingVerification struct {
+	tracker   game.Tracker
+	player    *game.PlayerInfo
+	challenge *game.Challenge
+	expires   time.Time
+}
+
+// challengeStore keeps the pending challenge of each user, one at a time
+type challengeStore struct {
+	mu      sync.Mutex
+	pending map[string]*pendingVerification // keyed by user ID
+}
+
+// put replaces the pending challenge of a user, dropping expired ones
+func (s *challengeStore) put(userID string, p *pendingVerification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == nil {
+		s.pending = make(map[string]*pendingVerification)
+	}
+	now := time.Now()
+	for id, other := range s.pending {
+		if now.After(other.expires) {
+			delete(s.pending, id)
+		}
+	}
+	s.pending[userID] = p
+}
+
+// get returns the unexpired pending challenge of a user, or nil
+func (s *challengeStore) get(userID string) *pendingVerification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pending[userID]
+	if p == nil || time.Now().After(p.expires) {
+		return nil
+	}
+	return p
+}
+
+// remove drops the pending challenge of a user
+func (s *challengeStore) remove(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pending, userID)
+}
+
+// verifyCommands returns the /연동 command, if any game supports ownership verification
+func (b *Bot) verifyCommands() []Command {
+	verifiers := game.Supporting[game.OwnershipVerifier](b.registry)
+	if len(verifiers) == 0 {
+		return nil
+	}
+
+	return []Command{
+		b.command(Spec{
+			Definition: &discordgo.ApplicationCommand{
+				Name:        "연동",
+				Description: "게임 계정이 내 것임을 인증하고 내 계정으로 연결합니다 (DM 알림, 알림 끄기 등)",
+				Contexts:    inGuildsAndDMs(),
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "시작",
+						Description: "인증을 시작합니다; 게임에서 안내에 따라 설정을 바꾼 뒤 /연동 확인",
+						Options: []*discordgo.ApplicationCommandOption{
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "게임",
+								Description: "게임",
+								Required:    true,
+								Choices:     gameChoices(verifiers),
+							},
+							{
+								Type:        discordgo.ApplicationCommandOptionString,
+								Name:        "플레이어",
+								Description: "내 게임 계정 ID (예: Faker#KR1)",
+								Required:    true,
+							},
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionSubCommand,
+						Name:        "확인",
+						Description: "게임에서 설정을 바꿨는지 확인하고 연동을 마칩니다",
+					},
+				},
+			},
+			Middleware: []Middleware{cooldown(5 * time.Second), deferReply(true)},
+			Run:        b.handleVerify,
+		}),
+	}
+}
+
+// handleVerify handles the /연동 command
+func (b *Bot) handleVerify(c *Context) error {
+	if c.Subcommand() == "확인" {
+		return b.handleVerifyCheck(c)
+	}
+
+	var opts playerOptions
+	if err := c.Bind(&opts); err != nil {
+		return err
+	}
+	l := c.Locale
+
+	tracker, err := b.trackerFor(opts.Game, opts.Player)
+	if err != nil {
+		return err
+	}
+	verifier, ok := tracker.(game.OwnershipVerifier)
+	if !ok {
+		return i18n.Errorf("verify.unsupported", gameName(l, tracker.Type(), tracker.Name()))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
+	defer cancel()
+
+	player, err := tracker.ResolvePlayer(ctx, opts.Player)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to look up player", "playerID", opts.Player, "error", err)
+		return i18n.Errorf("register.not_found", opts.Player)
+	}
+
+	if summoner, err := b.repo.GetSummonerByPUUIDAndGame(player.ID, string(player.GameType)); err == nil {
+		if link, err := b.repo.GetPlayerLink(summoner.ID); err == nil && link.Verified && link.UserID == c.UserID() {
+			return c.Reply(l.T("verify.already", player.DisplayName))
+		}
+	}
+
+	challenge, err := verifier.NewChallenge(ctx, player.ID)
+	if err != nil {
+		return err
+	}
+	b.challenges.put(c.UserID(), &pendingVerification{
+		tracker:   tracker,
+		player:    player,
+		challenge: challenge,
+		expires:   time.Now().Add(challengeTTL),
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       l.T("verify.title", player.DisplayName),
+		Description: challenge.Description + "\n\n" + l.T("verify.next", int(challengeTTL.Minutes())),
+		Color:       0x5865F2,
+	}
+	if challenge.ImageURL != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: challenge.ImageURL}
+	}
+	return c.ReplyEmbeds(embed)
+}
+
+// handleVerifyCheck completes the user's pending challenge: the player is
+// registered if needed, linked to the user as verified and followed by DM
+func (b *Bot) handleVerifyCheck(c *Context) error {
+	l := c.Locale
+	userID := c.UserID()
+
+	pending := b.challenges.get(userID)
+	if pending == nil {
+		return i18n.Errorf("verify.no_challenge")
+	}
+	verifier := pending.tracker.(game.OwnershipVerifier)
+
+	ctx, cancel := context.WithTimeout(c.Context(), 10*time.Second)
+	defer cancel()
+
+	ok, err := verifier.VerifyChallenge(ctx, pending.player.ID, pending.challenge)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return i18n.Errorf("verify.mismatch", pending.challenge.Description)
+	}
+
+	summoner, _, err := b.ensureSummoner(ctx, pending.tracker, pending.player)
+	if err != nil {
+		return err
+	}
+	if err := b.repo.LinkPlayer(&storage.PlayerLink{SummonerID: summoner.ID, UserID: userID, Verified: true}); err != nil {
+		slog.ErrorContext(ctx, "Failed to link player", "error", err)
+		return i18n.Errorf("link.failed")
+	}
+	b.challenges.remove(userID)
+	slog.InfoContext(ctx, "Player ownership verified", "summoner", summoner.RiotID, "userID", userID)
+
+	// Verified owners follow their own player by DM
+	err = b.repo.CreateSubscription(&storage.Subscription{
+		SummonerID:   summoner.ID,
+		TargetType:   storage.TargetUser,
+		TargetID:     userID,
+		RegisteredBy: userID,
+	})
+	if err != nil && !strings.Contains(err.Error(), "UNIQUE constraint") {
+		slog.ErrorContext(ctx, "Failed to create subscription", "error", err)
+	}
+	b.rememberUserLocale(c)
+
+	name := gameName(l, pending.tracker.Type(), pending.tracker.Name())
+	return c.Reply(l.T("verify.success", summoner.RiotID, name))
+}
+
+// isVerifiedOwner reports whether a user proved they own a summoner's account
+func (b *Bot) isVerifiedOwner(summonerID int64, userID string) bool {
+	link, err := b.repo.GetPlayerLink(summonerID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to get player link", "summonerID", summonerID, "error", err)
+		}
+		return false
+	}
+	return link.Verified && link.UserID == userID
+}
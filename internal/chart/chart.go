@@ -0,0 +1,232 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Kind selects how a panel's values are drawn
+type Kind int
+
+const (
+	KindLine Kind = iota
+	KindBar
+)
+
+// Panel is a single chart area stacked vertically in the rendered image
+// Labels are limited to ASCII because the built-in font has no CJK glyphs
+type Panel struct {
+	Title  string
+	Kind   Kind
+	Values []float64
+	Color  color.RGBA
+	Format string // fmt verb used for axis labels (e.g. "%.2f")
+}
+
+// Chart is a set of panels sharing the same x-axis labels
+type Chart struct {
+	Width       int
+	PanelHeight int
+	Labels      []string
+	Panels      []Panel
+}
+
+var (
+	background = color.RGBA{0x2B, 0x2D, 0x31, 0xFF} // Discord dark theme
+	gridColor  = color.RGBA{0x40, 0x44, 0x4B, 0xFF}
+	textColor  = color.RGBA{0xDC, 0xDD, 0xDE, 0xFF}
+)
+
+const (
+	marginLeft   = 70
+	marginRight  = 20
+	marginTop    = 24
+	marginBottom = 22
+)
+
+// RenderPNG draws the chart and encodes it as PNG
+func RenderPNG(c Chart) ([]byte, error) {
+	if len(c.Labels) == 0 {
+		return nil, fmt.Errorf("chart has no data points")
+	}
+	if c.Width == 0 {
+		c.Width = 800
+	}
+	if c.PanelHeight == 0 {
+		c.PanelHeight = 240
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, c.Width, c.PanelHeight*len(c.Panels)))
+	draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+
+	for idx, panel := range c.Panels {
+		area := image.Rect(0, idx*c.PanelHeight, c.Width, (idx+1)*c.PanelHeight)
+		drawPanel(img, area, c.Labels, panel)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode chart: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawPanel draws one panel into the given area of the image
+func drawPanel(img *image.RGBA, area image.Rectangle, labels []string, p Panel) {
+	plot := image.Rect(
+		area.Min.X+marginLeft, area.Min.Y+marginTop,
+		area.Max.X-marginRight, area.Max.Y-marginBottom,
+	)
+
+	drawText(img, area.Min.X+marginLeft, area.Min.Y+16, p.Title, textColor)
+
+	lo, hi := valueRange(p)
+	format := p.Format
+	if format == "" {
+		format = "%.0f"
+	}
+
+	// Horizontal grid lines with y-axis labels
+	const gridLines = 4
+	for g := 0; g <= gridLines; g++ {
+		y := plot.Max.Y - g*plot.Dy()/gridLines
+		hLine(img, plot.Min.X, plot.Max.X, y, gridColor)
+		value := lo + (hi-lo)*float64(g)/gridLines
+		drawText(img, area.Min.X+4, y+4, fmt.Sprintf(format, value), textColor)
+	}
+
+	n := len(labels)
+	step := float64(plot.Dx()) / float64(n)
+	xAt := func(i int) int { return plot.Min.X + int(step*(float64(i)+0.5)) }
+	yAt := func(v float64) int {
+		return plot.Max.Y - int(float64(plot.Dy())*(v-lo)/(hi-lo))
+	}
+
+	// X-axis labels, thinned out so they don't overlap
+	every := max(1, n/10)
+	for i, label := range labels {
+		if i%every == 0 || i == n-1 {
+			drawText(img, xAt(i)-len(label)*7/2, plot.Max.Y+16, label, textColor)
+		}
+	}
+
+	switch p.Kind {
+	case KindBar:
+		barWidth := max(2, int(step*0.6))
+		base := yAt(math.Max(lo, 0))
+		for i, v := range p.Values {
+			if i >= n {
+				break
+			}
+			top := yAt(v)
+			x0 := xAt(i) - barWidth/2
+			rect := image.Rect(x0, min(top, base), x0+barWidth, max(top, base)+1)
+			draw.Draw(img, rect, &image.Uniform{p.Color}, image.Point{}, draw.Src)
+		}
+	case KindLine:
+		for i := 1; i < len(p.Values) && i < n; i++ {
+			line(img, xAt(i-1), yAt(p.Values[i-1]), xAt(i), yAt(p.Values[i]), p.Color)
+		}
+		for i, v := range p.Values {
+			if i >= n {
+				break
+			}
+			dot(img, xAt(i), yAt(v), p.Color)
+		}
+	}
+}
+
+// valueRange returns a padded, non-empty value range for a panel
+func valueRange(p Panel) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range p.Values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	if math.IsInf(lo, 0) {
+		return 0, 1
+	}
+	if p.Kind == KindBar {
+		lo = math.Min(lo, 0)
+	}
+	if hi-lo < 1e-9 {
+		hi = lo + 1
+	}
+	pad := (hi - lo) * 0.05
+	if p.Kind == KindBar && lo == 0 {
+		return 0, hi + pad
+	}
+	return lo - pad, hi + pad
+}
+
+func drawText(img *image.RGBA, x, y int, text string, c color.RGBA) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  &image.Uniform{c},
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+func hLine(img *image.RGBA, x0, x1, y int, c color.RGBA) {
+	for x := x0; x <= x1; x++ {
+		img.SetRGBA(x, y, c)
+	}
+}
+
+// line draws a 2px line using Bresenham's algorithm
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.SetRGBA(x0, y0, c)
+		img.SetRGBA(x0, y0+1, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func dot(img *image.RGBA, x, y int, c color.RGBA) {
+	for ox := -2; ox <= 2; ox++ {
+		for oy := -2; oy <= 2; oy++ {
+			if ox*ox+oy*oy <= 5 {
+				img.SetRGBA(x+ox, y+oy, c)
+			}
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -14,12 +15,32 @@ type Config struct {
 	DiscordToken         string
 	DiscordApplicationID string
 
+	// Guild that receives slash commands instantly during development (optional)
+	// When set, commands are synced to this guild instead of globally
+	DevGuildID string
+
+	// Key signing notification button IDs (defaults to the bot token)
+	ComponentSecret string
+
 	// Riot API
 	RiotAPIKey string
 
 	// Nexon API
 	NexonAPIKey string
 
+	// MapleStory
+	MaplestoryLevelUpOnly  bool
+	MaplestoryBackfillDays int
+
+	// Steam Web API
+	SteamAPIKey string
+
+	// Telegram bot used for Telegram notification routes (optional)
+	TelegramBotToken string
+
+	// Custom trackers defined in a YAML file (optional)
+	CustomTrackersFile string
+
 	// Database
 	DatabasePath string
 
@@ -28,6 +49,9 @@ type Config struct {
 
 	// Logging
 	LogLevel string
+
+	// values holds the raw environment captured at load time for Get
+	values map[string]string
 }
 
 // Load reads configuration from environment variables
@@ -38,10 +62,16 @@ func Load() (*Config, error) {
 	cfg := &Config{
 		DiscordToken:         os.Getenv("DISCORD_BOT_TOKEN"),
 		DiscordApplicationID: os.Getenv("DISCORD_APPLICATION_ID"),
+		ComponentSecret:      os.Getenv("COMPONENT_SECRET"),
+		DevGuildID:           os.Getenv("DEV_GUILD_ID"),
 		RiotAPIKey:           os.Getenv("RIOT_API_KEY"),
 		NexonAPIKey:          os.Getenv("NEXON_API_KEY"),
+		SteamAPIKey:          os.Getenv("STEAM_API_KEY"),
+		TelegramBotToken:     os.Getenv("TELEGRAM_BOT_TOKEN"),
+		CustomTrackersFile:   os.Getenv("CUSTOM_TRACKERS_FILE"),
 		DatabasePath:         getEnvOrDefault("DATABASE_PATH", "./data/bot.db"),
 		LogLevel:             getEnvOrDefault("LOG_LEVEL", "info"),
+		values:               environ(),
 	}
 
 	// Parse polling interval
@@ -52,17 +82,50 @@ func Load() (*Config, error) {
 	}
 	cfg.PollingIntervalSeconds = polling
 
+	// Parse MapleStory notification mode
+	levelUpOnlyStr := getEnvOrDefault("MAPLESTORY_LEVELUP_ONLY", "false")
+	levelUpOnly, err := strconv.ParseBool(levelUpOnlyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAPLESTORY_LEVELUP_ONLY: %w", err)
+	}
+	cfg.MaplestoryLevelUpOnly = levelUpOnly
+
+	// Parse MapleStory snapshot backfill window
+	backfillStr := getEnvOrDefault("MAPLESTORY_BACKFILL_DAYS", "30")
+	backfill, err := strconv.Atoi(backfillStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAPLESTORY_BACKFILL_DAYS: %w", err)
+	}
+	cfg.MaplestoryBackfillDays = backfill
+
 	// Validate required fields
 	if cfg.DiscordToken == "" {
 		return nil, fmt.Errorf("DISCORD_BOT_TOKEN is required")
 	}
-	if cfg.RiotAPIKey == "" {
-		return nil, fmt.Errorf("RIOT_API_KEY is required")
+	if cfg.ComponentSecret == "" {
+		cfg.ComponentSecret = cfg.DiscordToken
 	}
 
 	return cfg, nil
 }
 
+// Get returns the raw value of a configuration key (environment variable)
+// Game trackers use it to check the keys they require
+func (c *Config) Get(key string) string {
+	return c.values[key]
+}
+
+// environ returns the current environment as a map
+func environ() map[string]string {
+	values := make(map[string]string)
+	for _, kv := range os.Environ() {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
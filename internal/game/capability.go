@@ -0,0 +1,60 @@
+package game
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// HistoryProvider is implemented by trackers that can list a player's past matches
+type HistoryProvider interface {
+	// RecentMatches returns an embed summarizing a player's last n matches
+	// Trackers may cap n
+	RecentMatches(ctx context.Context, playerID, playerName string, n int) (*discordgo.MessageEmbed, error)
+}
+
+// LiveStatusProvider is implemented by trackers that can tell whether a player
+// is in a game right now
+type LiveStatusProvider interface {
+	// LiveStatus returns an embed describing the game a player is in, or nil
+	// if they are not playing
+	LiveStatus(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error)
+}
+
+// StatsProvider is implemented by trackers that can show a player's detailed stats
+type StatsProvider interface {
+	// PlayerStats returns an embed with a player's current stats
+	PlayerStats(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error)
+}
+
+// ProfileProvider is implemented by trackers that can summarize a player in
+// a member's /프로필
+type ProfileProvider interface {
+	// PlayerProfile returns a few lines about a player, in the context's locale
+	PlayerProfile(ctx context.Context, playerID, playerName string) (string, error)
+}
+
+// Searchable is implemented by trackers that can find players by partial name,
+// used to autocomplete player options
+type Searchable interface {
+	// SearchPlayers returns up to limit players matching a query
+	SearchPlayers(ctx context.Context, query string, limit int) ([]SearchResult, error)
+}
+
+// SearchResult is a player found by a Searchable tracker
+type SearchResult struct {
+	Input string // What the tracker accepts as player input, e.g. in /등록
+	Name  string // Shown in suggestions; defaults to Input
+}
+
+// Supports reports whether a tracker implements the capability C,
+// e.g. Supports[HistoryProvider](tracker)
+func Supports[C any](tracker Tracker) bool {
+	_, ok := tracker.(C)
+	return ok
+}
+
+// Supporting returns the registered trackers implementing the capability C, ordered by type
+func Supporting[C any](r *Registry) []Tracker {
+	return r.Filter(Supports[C])
+}
@@ -0,0 +1,23 @@
+package game
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// MatchDetailProvider is implemented by match-based trackers that can show
+// more about a notified match on request
+type MatchDetailProvider interface {
+	// StateMatchID returns the match recorded in a state, or "" if none
+	StateMatchID(state *State) string
+
+	// Scoreboard returns an embed listing every player of a match
+	Scoreboard(ctx context.Context, matchID string) (*discordgo.MessageEmbed, error)
+}
+
+// ProfileLinker is implemented by trackers whose players have a public profile page
+type ProfileLinker interface {
+	// ProfileURL returns the profile page of a player
+	ProfileURL(playerID, playerName string) string
+}
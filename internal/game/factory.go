@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// Factory describes how to build a tracker from configuration
+// Game packages register their factory from init()
+type Factory struct {
+	Type GameType
+	Name string
+
+	// RequiredKeys lists the configuration keys (environment variables)
+	// that must be set for the tracker to be enabled
+	RequiredKeys []string
+
+	// New builds the tracker; it is only called once all required keys are set
+	New func(cfg *config.Config) (Tracker, error)
+}
+
+var (
+	factoriesMu sync.Mutex
+	factories   = make(map[GameType]Factory)
+)
+
+// RegisterFactory makes a tracker factory available to EnableTrackers
+// It panics if a factory for the same game type is registered twice
+func RegisterFactory(f Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if _, dup := factories[f.Type]; dup {
+		panic(fmt.Sprintf("game: factory for %s registered twice", f.Type))
+	}
+	factories[f.Type] = f
+}
+
+// Factories returns all registered factories ordered by game type
+func Factories() []Factory {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	list := make([]Factory, 0, len(factories))
+	for _, f := range factories {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Type < list[j].Type })
+	return list
+}
+
+// EnableTrackers builds every registered tracker whose configuration is
+// complete and registers it; the others are recorded as disabled with a reason
+func (r *Registry) EnableTrackers(cfg *config.Config) {
+	for _, f := range Factories() {
+		var missing []string
+		for _, key := range f.RequiredKeys {
+			if cfg.Get(key) == "" {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			r.Disable(f.Type, f.Name, i18n.Errorf("games.missing_config", strings.Join(missing, ", ")))
+			continue
+		}
+
+		tracker, err := f.New(cfg)
+		if err != nil {
+			r.Disable(f.Type, f.Name, i18n.Wrap(err, "games.init_failed"))
+			continue
+		}
+		r.Register(tracker)
+	}
+}
@@ -0,0 +1,47 @@
+package game
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// MaxRecordedMatches caps the matches recorded from one state change, for
+// players who played many games between polls
+const MaxRecordedMatches = 20
+
+// MatchResult summarizes one completed match of a player
+type MatchResult struct {
+	MatchID   string
+	Win       bool
+	Kills     int
+	Deaths    int
+	Assists   int
+	Character string // champion, agent, etc.
+	PlayedAt  time.Time
+
+	// Highlights are notable moments of the match, e.g. a pentakill
+	Highlights []Event
+}
+
+// MatchRecorder is implemented by match-based trackers whose completed
+// matches are recorded for history and recap reports
+type MatchRecorder interface {
+	// MatchResults returns the player's matches completed in a state change
+	MatchResults(ctx context.Context, playerID string, change StateChange) ([]MatchResult, error)
+}
+
+// NewMatchIDs returns the matches of a newest-first match ID list played
+// since the previous match, from the current match back
+// Only the current match is returned if the list doesn't contain it
+func NewMatchIDs(matchIDs []string, previous, current string) []string {
+	start := slices.Index(matchIDs, current)
+	if start < 0 {
+		return []string{current}
+	}
+	ids := matchIDs[start:]
+	if end := slices.Index(ids, previous); end >= 0 {
+		ids = ids[:end]
+	}
+	return ids
+}
@@ -0,0 +1,31 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewMatchIDs(t *testing.T) {
+	recent := []string{"m5", "m4", "m3", "m2", "m1"}
+
+	tests := []struct {
+		name              string
+		matchIDs          []string
+		previous, current string
+		want              []string
+	}{
+		{"one new match", recent, "m4", "m5", []string{"m5"}},
+		{"games between polls", recent, "m2", "m5", []string{"m5", "m4", "m3"}},
+		{"newer than the current state", recent, "m2", "m4", []string{"m4", "m3"}},
+		{"previous beyond the list", recent, "m0", "m5", recent},
+		{"current missing from the list", recent, "m4", "m6", []string{"m6"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMatchIDs(tt.matchIDs, tt.previous, tt.current); !slices.Equal(got, tt.want) {
+				t.Errorf("NewMatchIDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
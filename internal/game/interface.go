@@ -11,7 +11,10 @@ type GameType string
 
 const (
 	GameTypeLoL        GameType = "lol"
+	GameTypeTFT        GameType = "tft"
+	GameTypeValorant   GameType = "valorant"
 	GameTypeMaplestory GameType = "maplestory"
+	GameTypeSteam      GameType = "steam"
 )
 
 // PlayerInfo contains common player identification information
@@ -24,6 +27,8 @@ type PlayerInfo struct {
 // Tracker defines the interface that all game trackers must implement
 // This interface is generic enough to support both match-based games (LoL)
 // and progression-based games (MapleStory)
+// Games opt into more features by also implementing capability interfaces,
+// such as those in capability.go, which callers discover by type assertion
 type Tracker interface {
 	// Name returns the human-readable name of the game
 	Name() string
@@ -42,13 +47,20 @@ type Tracker interface {
 	// The input format depends on the game (e.g., "Name#Tag" for Riot games)
 	ResolvePlayer(ctx context.Context, input string) (*PlayerInfo, error)
 
-	// GetCurrentState returns a state identifier for change detection
-	// For match-based games: returns latest match ID
-	// For progression games: returns a hash of current state (e.g., "lv:275:exp:12345")
-	GetCurrentState(ctx context.Context, playerID string) (string, error)
+	// GetCurrentState returns the player's current state for change detection
+	// For match-based games: the latest match
+	// For progression games: a snapshot of level, EXP, equipment, etc.
+	// Returns a nil state if there is nothing to track yet
+	GetCurrentState(ctx context.Context, playerID string) (*State, error)
 
-	// CreateNotification creates a Discord embed for a state change notification
+	// CompareStates compares a previously stored state with the current one and
+	// returns the events that occurred, if any. The previous state may be of an
+	// older version (including LegacyStateVersion) and must be upgraded as needed
+	CompareStates(prev, cur *State) ([]Event, error)
+
+	// CreateNotification creates a Discord embed for a state change
 	// playerID allows the tracker to fetch fresh data if needed
-	// stateID is the new state that triggered the notification
-	CreateNotification(ctx context.Context, playerID, playerName, stateID string) (*discordgo.MessageEmbed, error)
+	// With no events in the change, it describes the current state instead
+	// Returns a nil embed if the change is not worth notifying about
+	CreateNotification(ctx context.Context, playerID, playerName string, change StateChange) (*discordgo.MessageEmbed, error)
 }
@@ -0,0 +1,20 @@
+package game
+
+import "context"
+
+// Challenge is a task that proves a user controls a player's account
+type Challenge struct {
+	Token       string // what the tracker checks for, e.g. a profile icon ID
+	Description string // instructions for the user, in the context's locale
+	ImageURL    string // optional picture of what to set
+}
+
+// OwnershipVerifier is implemented by trackers that can check whether a user
+// controls a player's account, by asking for a change only the owner can make
+type OwnershipVerifier interface {
+	// NewChallenge returns a challenge for the player's owner to complete in game
+	NewChallenge(ctx context.Context, playerID string) (*Challenge, error)
+
+	// VerifyChallenge reports whether the player completed the challenge
+	VerifyChallenge(ctx context.Context, playerID string, challenge *Challenge) (bool, error)
+}
@@ -0,0 +1,81 @@
+package game
+
+import "fmt"
+
+// tierOrder lists ranked tiers from lowest to highest
+var tierOrder = []string{
+	"IRON", "BRONZE", "SILVER", "GOLD", "PLATINUM", "EMERALD", "DIAMOND",
+	"MASTER", "GRANDMASTER", "CHALLENGER",
+}
+
+// divisionOrder lists divisions within a tier from lowest to highest
+var divisionOrder = []string{"IV", "III", "II", "I"}
+
+// apexTierSpan is the score range of one apex tier, above any real LP
+const apexTierSpan = 100_000
+
+// Standing is a player's ranked standing (e.g. GOLD II 45LP)
+type Standing struct {
+	Tier     string
+	Division string // empty for apex tiers
+	LP       int
+}
+
+// Score returns a number that orders standings; higher is better
+func (s Standing) Score() int {
+	tier := indexOf(tierOrder, s.Tier)
+	division := max(indexOf(divisionOrder, s.Division), 0)
+	if master := indexOf(tierOrder, "MASTER"); tier >= master {
+		// Apex tiers have no divisions and LP keeps growing, so each apex tier
+		// starts above any LP the one below it can reach
+		return master*400 + (tier-master)*apexTierSpan + s.LP
+	}
+	return tier*400 + division*100 + s.LP
+}
+
+// String formats the standing as e.g. "GOLD II 45LP"
+func (s Standing) String() string {
+	if s.Division == "" || indexOf(tierOrder, s.Tier) >= indexOf(tierOrder, "MASTER") {
+		return fmt.Sprintf("%s %dLP", s.Tier, s.LP)
+	}
+	return fmt.Sprintf("%s %s %dLP", s.Tier, s.Division, s.LP)
+}
+
+// RankReader is implemented by trackers whose stored state includes a ranked standing
+type RankReader interface {
+	// StateStanding returns the standing recorded in a state, or nil if unranked
+	StateStanding(state *State) (*Standing, error)
+}
+
+// Promoted reports whether a change moved the player to a higher standing
+// It is false for trackers without ranks and for changes without a rank event
+func Promoted(tracker Tracker, change StateChange) bool {
+	reader, ok := tracker.(RankReader)
+	if !ok || change.Previous == nil || !change.HasEvent(EventRankChanged) {
+		return false
+	}
+	before, err := reader.StateStanding(change.Previous)
+	if err != nil || before == nil {
+		return false
+	}
+	after, err := reader.StateStanding(change.Current)
+	if err != nil || after == nil {
+		return false
+	}
+	return after.Score() > before.Score()
+}
+
+func indexOf(list []string, value string) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// LevelReader is implemented by progression trackers whose stored state includes a level
+type LevelReader interface {
+	// StateLevel returns the level and the EXP within that level recorded in a state
+	StateLevel(state *State) (level, exp int64, err error)
+}
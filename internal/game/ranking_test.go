@@ -0,0 +1,101 @@
+package game
+
+import "testing"
+
+func TestStandingScoreOrder(t *testing.T) {
+	// Lowest to highest
+	standings := []Standing{
+		{Tier: "IRON", Division: "IV", LP: 0},
+		{Tier: "IRON", Division: "IV", LP: 99},
+		{Tier: "IRON", Division: "III", LP: 0},
+		{Tier: "BRONZE", Division: "IV", LP: 0},
+		{Tier: "GOLD", Division: "II", LP: 45},
+		{Tier: "DIAMOND", Division: "I", LP: 99},
+		{Tier: "MASTER", LP: 0},
+		{Tier: "MASTER", LP: 1200},
+		{Tier: "GRANDMASTER", LP: 0},
+		{Tier: "GRANDMASTER", LP: 900},
+		{Tier: "CHALLENGER", LP: 0},
+		{Tier: "CHALLENGER", LP: 2500},
+	}
+
+	for i := 1; i < len(standings); i++ {
+		lower, higher := standings[i-1], standings[i]
+		if lower.Score() >= higher.Score() {
+			t.Errorf("%s scores %d, not below %s at %d", lower, lower.Score(), higher, higher.Score())
+		}
+	}
+}
+
+func TestStandingString(t *testing.T) {
+	tests := []struct {
+		standing Standing
+		want     string
+	}{
+		{Standing{Tier: "GOLD", Division: "II", LP: 45}, "GOLD II 45LP"},
+		{Standing{Tier: "MASTER", Division: "I", LP: 120}, "MASTER 120LP"},
+		{Standing{Tier: "CHALLENGER", LP: 1500}, "CHALLENGER 1500LP"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.standing.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+// rankedTracker reads standings stored as JSON
+type rankedTracker struct {
+	Tracker
+}
+
+func (rankedTracker) StateStanding(state *State) (*Standing, error) {
+	var s Standing
+	if err := state.Decode(&s); err != nil {
+		return nil, err
+	}
+	if s.Tier == "" {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func TestPromoted(t *testing.T) {
+	state := func(s Standing) *State {
+		st, err := NewState(1, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return st
+	}
+	gold := state(Standing{Tier: "GOLD", Division: "II", LP: 90})
+	platinum := state(Standing{Tier: "PLATINUM", Division: "IV", LP: 0})
+	master := state(Standing{Tier: "MASTER", LP: 450})
+	grandmaster := state(Standing{Tier: "GRANDMASTER", LP: 0})
+	unranked := state(Standing{})
+	rankEvent := []Event{{Type: EventRankChanged}}
+
+	tests := []struct {
+		name    string
+		tracker Tracker
+		change  StateChange
+		want    bool
+	}{
+		{"promoted", rankedTracker{}, StateChange{Previous: gold, Current: platinum, Events: rankEvent}, true},
+		{"demoted", rankedTracker{}, StateChange{Previous: platinum, Current: gold, Events: rankEvent}, false},
+		{"apex promotion", rankedTracker{}, StateChange{Previous: master, Current: grandmaster, Events: rankEvent}, true},
+		{"apex demotion", rankedTracker{}, StateChange{Previous: grandmaster, Current: master, Events: rankEvent}, false},
+		{"no rank event", rankedTracker{}, StateChange{Previous: gold, Current: platinum, Events: []Event{{Type: EventMatchCompleted}}}, false},
+		{"no previous state", rankedTracker{}, StateChange{Current: platinum, Events: rankEvent}, false},
+		{"placed from unranked", rankedTracker{}, StateChange{Previous: unranked, Current: gold, Events: rankEvent}, false},
+		{"tracker without ranks", nil, StateChange{Previous: gold, Current: platinum, Events: rankEvent}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Promoted(tt.tracker, tt.change); got != tt.want {
+				t.Errorf("Promoted = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 )
 
@@ -9,12 +10,14 @@ import (
 type Registry struct {
 	mu       sync.RWMutex
 	trackers map[GameType]Tracker
+	disabled map[GameType]DisabledGame
 }
 
 // NewRegistry creates a new game registry
 func NewRegistry() *Registry {
 	return &Registry{
 		trackers: make(map[GameType]Tracker),
+		disabled: make(map[GameType]DisabledGame),
 	}
 }
 
@@ -23,6 +26,27 @@ func (r *Registry) Register(tracker Tracker) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.trackers[tracker.Type()] = tracker
+	delete(r.disabled, tracker.Type())
+}
+
+// Disable records that a known game is not available and why
+func (r *Registry) Disable(gameType GameType, name string, reason error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.disabled[gameType] = DisabledGame{Type: gameType, Name: name, Reason: reason}
+}
+
+// ListDisabled returns the games that are known but not enabled, ordered by type
+func (r *Registry) ListDisabled() []DisabledGame {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	games := make([]DisabledGame, 0, len(r.disabled))
+	for _, g := range r.disabled {
+		games = append(games, g)
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].Type < games[j].Type })
+	return games
 }
 
 // Get retrieves a tracker by game type
@@ -46,6 +70,18 @@ func (r *Registry) GetAll() []Tracker {
 	for _, tracker := range r.trackers {
 		trackers = append(trackers, tracker)
 	}
+	sort.Slice(trackers, func(i, j int) bool { return trackers[i].Type() < trackers[j].Type() })
+	return trackers
+}
+
+// Filter returns the registered trackers for which keep returns true, ordered by type
+func (r *Registry) Filter(keep func(Tracker) bool) []Tracker {
+	var trackers []Tracker
+	for _, tracker := range r.GetAll() {
+		if keep(tracker) {
+			trackers = append(trackers, tracker)
+		}
+	}
 	return trackers
 }
 
@@ -62,6 +98,7 @@ func (r *Registry) List() []GameInfo {
 			Description: tracker.Description(),
 		})
 	}
+	sort.Slice(games, func(i, j int) bool { return games[i].Type < games[j].Type })
 	return games
 }
 
@@ -71,3 +108,10 @@ type GameInfo struct {
 	Name        string
 	Description string
 }
+
+// DisabledGame describes a game whose tracker could not be enabled
+type DisabledGame struct {
+	Type   GameType
+	Name   string
+	Reason error
+}
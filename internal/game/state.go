@@ -0,0 +1,103 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LegacyStateVersion marks a state converted from the old opaque string
+// stored in summoners.last_match_id; its data is that string, JSON-encoded
+const LegacyStateVersion = 0
+
+// State is a versioned, JSON-serializable snapshot of a tracked player
+// Each tracker defines its own data layout and version numbers
+type State struct {
+	Version int
+	Data    json.RawMessage
+}
+
+// NewState encodes tracker-specific data into a State
+func NewState(version int, data any) (*State, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state: %w", err)
+	}
+	return &State{Version: version, Data: raw}, nil
+}
+
+// LegacyState wraps an old opaque state string
+func LegacyState(value string) *State {
+	raw, _ := json.Marshal(value)
+	return &State{Version: LegacyStateVersion, Data: raw}
+}
+
+// Decode unmarshals the state data into v
+func (s *State) Decode(v any) error {
+	if err := json.Unmarshal(s.Data, v); err != nil {
+		return fmt.Errorf("failed to decode state (version %d): %w", s.Version, err)
+	}
+	return nil
+}
+
+// Legacy returns the old opaque string if this is a legacy state
+func (s *State) Legacy() (string, bool) {
+	if s.Version != LegacyStateVersion {
+		return "", false
+	}
+	var value string
+	if err := json.Unmarshal(s.Data, &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// Equal reports whether two states have the same version and data
+func (s *State) Equal(other *State) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.Version == other.Version && bytes.Equal(s.Data, other.Data)
+}
+
+// EventType identifies the kind of change a tracker detected
+type EventType string
+
+const (
+	EventMatchCompleted     EventType = "match_completed"
+	EventRankChanged        EventType = "rank_changed"
+	EventPentakill          EventType = "pentakill"
+	EventLevelUp            EventType = "level_up"
+	EventExpGained          EventType = "exp_gained"
+	EventEquipmentChanged   EventType = "equipment_changed"
+	EventCombatPowerChanged EventType = "combat_power_changed"
+	EventAchievementUnlock  EventType = "achievement_unlocked"
+	EventPlaytimeJump       EventType = "playtime_jump"
+	EventNewGame            EventType = "new_game"
+	EventStateChanged       EventType = "state_changed"
+)
+
+// Event is a single typed change detected between two states
+type Event struct {
+	Type    EventType
+	Summary string // Short human-readable description (e.g. "⭐ 무기 22성 달성")
+}
+
+// StateChange describes a transition between two observed states of a player
+type StateChange struct {
+	Previous   *State    // Previously stored state (nil if unknown)
+	Current    *State    // Newly observed state
+	PreviousAt time.Time // When the previous state was recorded (zero if unknown)
+	Events     []Event   // Events detected between the two states (empty for status lookups)
+}
+
+// HasEvent reports whether the change contains an event of the given type
+func (c StateChange) HasEvent(eventType EventType) bool {
+	for _, e := range c.Events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package custom
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"gopkg.in/yaml.v3"
+)
+
+// File is the root of a custom tracker definition file
+type File struct {
+	Trackers []Definition `yaml:"trackers"`
+}
+
+// Definition describes a tracker backed by a simple HTTP JSON API
+type Definition struct {
+	Type        string `yaml:"type"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// PlayerPattern is an optional regular expression player IDs must match
+	PlayerPattern string `yaml:"player_pattern"`
+
+	// Headers are sent with every request (values may reference environment variables as ${VAR})
+	Headers map[string]string `yaml:"headers"`
+
+	Resolve   ResolveConfig   `yaml:"resolve"`
+	State     StateConfig     `yaml:"state"`
+	Embed     EmbedConfig     `yaml:"embed"`
+	Search    SearchConfig    `yaml:"search"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+}
+
+// ResolveConfig describes how a player input is looked up
+type ResolveConfig struct {
+	// URL is a template executed with {{.Input}}
+	URL string `yaml:"url"`
+	// ID and Name are JSONPath expressions into the response
+	// ID defaults to the input; Name defaults to the ID
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+}
+
+// StateConfig describes how the tracked state is fetched
+type StateConfig struct {
+	// URL is a template executed with {{.PlayerID}}
+	URL string `yaml:"url"`
+	// Path is a JSONPath expression selecting the value to track
+	Path string `yaml:"path"`
+}
+
+// SearchConfig optionally describes how players are found by partial name,
+// to autocomplete player options
+type SearchConfig struct {
+	// URL is a template executed with {{.Query}}
+	URL string `yaml:"url"`
+	// Results is a JSONPath expression selecting the list of players in the response
+	Results string `yaml:"results"`
+	// Input and Name are JSONPath expressions into each result
+	// Input is what resolve accepts; Name defaults to it
+	Input string `yaml:"input"`
+	Name  string `yaml:"name"`
+}
+
+// EmbedConfig describes the notification embed
+// Text fields are templates executed with NotificationData
+type EmbedConfig struct {
+	Title       string        `yaml:"title"`
+	Description string        `yaml:"description"`
+	URL         string        `yaml:"url"`
+	Color       int           `yaml:"color"`
+	Thumbnail   string        `yaml:"thumbnail"`
+	Fields      []FieldConfig `yaml:"fields"`
+}
+
+// FieldConfig describes one embed field
+type FieldConfig struct {
+	Name   string `yaml:"name"`
+	Value  string `yaml:"value"`
+	Inline bool   `yaml:"inline"`
+}
+
+// RateLimitConfig limits requests made by one tracker
+type RateLimitConfig struct {
+	Requests int           `yaml:"requests"`
+	Per      time.Duration `yaml:"per"`
+}
+
+// interval returns the minimum time between requests
+func (r RateLimitConfig) interval() time.Duration {
+	if r.Requests <= 0 || r.Per <= 0 {
+		return time.Second
+	}
+	return r.Per / time.Duration(r.Requests)
+}
+
+var typePattern = regexp.MustCompile(`^[a-z0-9_-]{2,32}$`)
+
+// LoadFile reads and validates a custom tracker definition file
+func LoadFile(path string) ([]Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool)
+	for i := range file.Trackers {
+		def := &file.Trackers[i]
+		if err := def.validate(); err != nil {
+			return nil, fmt.Errorf("%s: tracker %d (%s): %w", path, i+1, def.Type, err)
+		}
+		if seen[def.Type] {
+			return nil, fmt.Errorf("%s: duplicate tracker type %q", path, def.Type)
+		}
+		seen[def.Type] = true
+	}
+
+	return file.Trackers, nil
+}
+
+// validate checks that a definition is complete and its templates and paths parse
+func (d *Definition) validate() error {
+	if !typePattern.MatchString(d.Type) {
+		return fmt.Errorf("type must match %s", typePattern)
+	}
+	if d.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if d.Resolve.URL == "" {
+		return fmt.Errorf("resolve.url is required")
+	}
+	if d.State.URL == "" || d.State.Path == "" {
+		return fmt.Errorf("state.url and state.path are required")
+	}
+	if d.PlayerPattern != "" {
+		if _, err := regexp.Compile(d.PlayerPattern); err != nil {
+			return fmt.Errorf("invalid player_pattern: %w", err)
+		}
+	}
+
+	if d.Search.URL != "" && (d.Search.Results == "" || d.Search.Input == "") {
+		return fmt.Errorf("search.results and search.input are required with search.url")
+	}
+
+	for _, expr := range []string{d.Resolve.ID, d.Resolve.Name, d.State.Path, d.Search.Results, d.Search.Input, d.Search.Name} {
+		if expr == "" {
+			continue
+		}
+		if _, err := parsePath(expr); err != nil {
+			return err
+		}
+	}
+
+	templates := []string{d.Resolve.URL, d.State.URL, d.Search.URL, d.Embed.Title, d.Embed.Description, d.Embed.URL, d.Embed.Thumbnail}
+	for _, f := range d.Embed.Fields {
+		templates = append(templates, f.Name, f.Value)
+	}
+	for _, text := range templates {
+		if _, err := parseTemplate(text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandHeaders returns the headers with ${VAR} references replaced from the environment
+func (d *Definition) expandHeaders() map[string]string {
+	headers := make(map[string]string, len(d.Headers))
+	for k, v := range d.Headers {
+		headers[k] = os.Expand(v, os.Getenv)
+	}
+	return headers
+}
+
+// parseTemplate parses a text template with the helper functions available to definitions
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("").Option("missingkey=zero").Funcs(template.FuncMap{
+		"path":  lookupString,
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %q: %w", text, err)
+	}
+	return tmpl, nil
+}
+
+// Register loads a definition file and registers a tracker for each definition
+// Types already used by built-in games are rejected
+func Register(registry *game.Registry, path string) ([]*Tracker, error) {
+	defs, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make(map[game.GameType]bool)
+	for _, f := range game.Factories() {
+		reserved[f.Type] = true
+	}
+
+	trackers := make([]*Tracker, 0, len(defs))
+	for _, def := range defs {
+		if reserved[game.GameType(def.Type)] {
+			return nil, fmt.Errorf("%s: tracker type %q is already used by a built-in game", path, def.Type)
+		}
+		tracker, err := NewTracker(def)
+		if err != nil {
+			return nil, fmt.Errorf("%s: tracker %s: %w", path, def.Type, err)
+		}
+		trackers = append(trackers, tracker)
+	}
+
+	for _, tracker := range trackers {
+		// Only trackers with a search configured are game.Searchable
+		if tracker.searchURL != nil {
+			registry.Register(&searchableTracker{tracker})
+		} else {
+			registry.Register(tracker)
+		}
+	}
+	return trackers, nil
+}
@@ -0,0 +1,143 @@
+package custom
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeDefinitions writes a definition file into a temporary directory
+func writeDefinitions(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "trackers.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeDefinitions(t, `
+trackers:
+  - type: chess
+    name: Chess.com
+    player_pattern: '^[a-z0-9_-]+$'
+    headers:
+      User-Agent: discord-bot
+    resolve:
+      url: https://api.chess.com/pub/player/{{.Input}}
+      id: $.username
+      name: $.name
+    state:
+      url: https://api.chess.com/pub/player/{{.PlayerID}}/stats
+      path: $.chess_rapid.last.rating
+    embed:
+      title: '{{.PlayerName}} {{.Previous}} → {{.Current}}'
+      fields:
+        - name: Best
+          value: '{{path .Data "$.chess_rapid.best.rating"}}'
+    rate_limit:
+      requests: 2
+      per: 1s
+`)
+
+	defs, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+	if len(defs) != 1 {
+		t.Fatalf("got %d definitions, want 1", len(defs))
+	}
+	def := defs[0]
+	if def.Type != "chess" || def.State.Path != "$.chess_rapid.last.rating" || len(def.Embed.Fields) != 1 {
+		t.Errorf("definition = %+v", def)
+	}
+	if got := def.RateLimit.interval(); got != 500*time.Millisecond {
+		t.Errorf("interval = %v, want 500ms", got)
+	}
+}
+
+func TestLoadFileValidation(t *testing.T) {
+	const valid = `
+  - type: chess
+    name: Chess.com
+    resolve:
+      url: https://example.com/{{.Input}}
+    state:
+      url: https://example.com/{{.PlayerID}}
+      path: $.rating
+`
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{
+			name: "bad type",
+			yaml: strings.Replace(valid, "type: chess", "type: Chess!", 1),
+			want: "type must match",
+		},
+		{
+			name: "missing name",
+			yaml: strings.Replace(valid, "name: Chess.com", "name: ''", 1),
+			want: "name is required",
+		},
+		{
+			name: "missing resolve url",
+			yaml: strings.Replace(valid, "url: https://example.com/{{.Input}}", "url: ''", 1),
+			want: "resolve.url is required",
+		},
+		{
+			name: "missing state path",
+			yaml: strings.Replace(valid, "path: $.rating", "path: ''", 1),
+			want: "state.url and state.path are required",
+		},
+		{
+			name: "bad player pattern",
+			yaml: valid + "    player_pattern: '(['\n",
+			want: "invalid player_pattern",
+		},
+		{
+			name: "bad path",
+			yaml: strings.Replace(valid, "path: $.rating", "path: rating", 1),
+			want: "must start with $",
+		},
+		{
+			name: "bad template",
+			yaml: strings.Replace(valid, "{{.PlayerID}}", "{{.PlayerID", 1),
+			want: "invalid template",
+		},
+		{
+			name: "search without results",
+			yaml: valid + "    search:\n      url: https://example.com/search?q={{.Query}}\n",
+			want: "search.results and search.input are required",
+		},
+		{
+			name: "duplicate type",
+			yaml: valid + valid,
+			want: "duplicate tracker type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadFile(writeDefinitions(t, "trackers:"+tt.yaml))
+			if err == nil {
+				t.Fatalf("LoadFile: want error containing %q", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadFile error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandHeaders(t *testing.T) {
+	t.Setenv("CUSTOM_TEST_TOKEN", "secret")
+	def := Definition{Headers: map[string]string{"Authorization": "Bearer ${CUSTOM_TEST_TOKEN}"}}
+
+	if got := def.expandHeaders()["Authorization"]; got != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+	}
+}
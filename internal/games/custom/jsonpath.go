@@ -0,0 +1,132 @@
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathStep is one step of a parsed JSONPath expression: a key or an array index
+type pathStep struct {
+	key   string
+	index int
+	isIdx bool
+}
+
+// parsePath parses the supported JSONPath subset:
+// $, .key, ['key'], ["key"] and [index] (negative indexes count from the end)
+func parsePath(expr string) ([]pathStep, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("invalid path %q: must start with $", expr)
+	}
+
+	var steps []pathStep
+	rest := expr[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty key", expr)
+			}
+			steps = append(steps, pathStep{key: rest[:end]})
+			rest = rest[end:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", expr)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				steps = append(steps, pathStep{key: inner[1 : len(inner)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: bad index %q", expr, inner)
+			}
+			steps = append(steps, pathStep{index: index, isIdx: true})
+
+		default:
+			return nil, fmt.Errorf("invalid path %q: unexpected %q", expr, rest[0])
+		}
+	}
+
+	return steps, nil
+}
+
+// lookup evaluates a JSONPath expression against decoded JSON
+// It returns false if the path does not exist
+func lookup(data any, expr string) (any, bool) {
+	steps, err := parsePath(expr)
+	if err != nil {
+		return nil, false
+	}
+
+	cur := data
+	for _, step := range steps {
+		if step.isIdx {
+			list, ok := cur.([]any)
+			if !ok {
+				return nil, false
+			}
+			i := step.index
+			if i < 0 {
+				i += len(list)
+			}
+			if i < 0 || i >= len(list) {
+				return nil, false
+			}
+			cur = list[i]
+			continue
+		}
+
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = obj[step.key]; !ok {
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+// lookupString evaluates a JSONPath expression and formats the result as text
+// Objects and arrays are rendered as compact JSON; missing values are empty
+func lookupString(data any, expr string) string {
+	value, ok := lookup(data, expr)
+	if !ok {
+		return ""
+	}
+	return formatValue(value)
+}
+
+// formatValue formats a decoded JSON value as text
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(raw)
+	}
+}
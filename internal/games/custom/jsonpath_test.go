@@ -0,0 +1,81 @@
+package custom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.UseNumber()
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestLookupString(t *testing.T) {
+	data := decodeJSON(t, `{
+		"name": "magnus",
+		"stats": {"rapid": {"rating": 2850, "provisional": false}},
+		"games": [{"id": "a"}, {"id": "b"}, {"id": "c"}],
+		"odd key": "spaced",
+		"empty": null
+	}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"$.name", "magnus"},
+		{"$.stats.rapid.rating", "2850"},
+		{"$.stats.rapid.provisional", "false"},
+		{"$['stats'][\"rapid\"].rating", "2850"},
+		{"$['odd key']", "spaced"},
+		{"$.games[0].id", "a"},
+		{"$.games[-1].id", "c"},
+		{"$.games[1]", `{"id":"b"}`},
+		{"$.stats.rapid", `{"provisional":false,"rating":2850}`},
+		{"$.empty", ""},
+		{"$.missing", ""},
+		{"$.games[3].id", ""},
+		{"$.games[-4]", ""},
+		{"$.name.first", ""},
+		{"$.stats[0]", ""},
+	}
+	for _, tt := range tests {
+		if got := lookupString(data, tt.expr); got != tt.want {
+			t.Errorf("lookupString(%s) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestLookupRoot(t *testing.T) {
+	data := decodeJSON(t, `[1, 2]`)
+
+	value, ok := lookup(data, "$")
+	if !ok {
+		t.Fatal("lookup($): want the whole document")
+	}
+	if list, ok := value.([]any); !ok || len(list) != 2 {
+		t.Errorf("lookup($) = %v", value)
+	}
+}
+
+func TestParsePathErrors(t *testing.T) {
+	for _, expr := range []string{
+		"name",
+		"$.",
+		"$..name",
+		"$[0",
+		"$[x]",
+		"$name",
+	} {
+		if _, err := parsePath(expr); err == nil {
+			t.Errorf("parsePath(%q): want error", expr)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+// searchableTracker is a Tracker whose definition configures a player search
+type searchableTracker struct {
+	*Tracker
+}
+
+// SearchPlayers fetches the search URL and reads up to limit players from its results
+func (t *searchableTracker) SearchPlayers(ctx context.Context, query string, limit int) ([]game.SearchResult, error) {
+	endpoint, err := execute(t.searchURL, map[string]string{"Query": url.QueryEscape(query)})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.fetch(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	list, _ := lookup(data, t.def.Search.Results)
+	items, ok := list.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list in the search response", t.def.Search.Results)
+	}
+
+	var results []game.SearchResult
+	for _, item := range items {
+		if len(results) == limit {
+			break
+		}
+		input := lookupString(item, t.def.Search.Input)
+		if input == "" {
+			continue
+		}
+		name := input
+		if t.def.Search.Name != "" {
+			if n := lookupString(item, t.def.Search.Name); n != "" {
+				name = n
+			}
+		}
+		results = append(results, game.SearchResult{Input: input, Name: name})
+	}
+
+	return results, nil
+}
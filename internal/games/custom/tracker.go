@@ -0,0 +1,365 @@
+package custom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+const (
+	// stateVersion is the current version of valueState
+	stateVersion = 1
+
+	// maxResponseSize caps how much of a response body is read
+	maxResponseSize = 1 << 20
+)
+
+// valueState is the tracked state: the raw JSON value selected by state.path
+type valueState struct {
+	Value json.RawMessage `json:"value"`
+}
+
+// NotificationData is the data available to embed templates
+type NotificationData struct {
+	PlayerID   string
+	PlayerName string
+	Previous   string // previous value, empty for a status embed
+	Current    string
+	Changed    bool
+	Data       any // the full decoded state response, for use with {{path .Data "$.x"}}
+}
+
+// Tracker implements game.Tracker from a Definition
+type Tracker struct {
+	def        Definition
+	headers    map[string]string
+	pattern    *regexp.Regexp
+	httpClient *http.Client
+
+	resolveURL *template.Template
+	stateURL   *template.Template
+	searchURL  *template.Template // nil without a search configured
+
+	// Simple rate limiter
+	mu          sync.Mutex
+	lastRequest time.Time
+	minInterval time.Duration
+}
+
+// NewTracker creates a tracker from a validated definition
+func NewTracker(def Definition) (*Tracker, error) {
+	if err := def.validate(); err != nil {
+		return nil, err
+	}
+
+	t := &Tracker{
+		def:     def,
+		headers: def.expandHeaders(),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		minInterval: def.RateLimit.interval(),
+	}
+	if def.PlayerPattern != "" {
+		t.pattern = regexp.MustCompile(def.PlayerPattern)
+	}
+	t.resolveURL, _ = parseTemplate(def.Resolve.URL)
+	t.stateURL, _ = parseTemplate(def.State.URL)
+	if def.Search.URL != "" {
+		t.searchURL, _ = parseTemplate(def.Search.URL)
+	}
+
+	return t, nil
+}
+
+// Name returns the human-readable name of the game
+func (t *Tracker) Name() string {
+	return t.def.Name
+}
+
+// Type returns the game type identifier
+func (t *Tracker) Type() game.GameType {
+	return game.GameType(t.def.Type)
+}
+
+// Description returns the configured description of the game
+// Without one the bot shows a generic localized description
+func (t *Tracker) Description() string {
+	return t.def.Description
+}
+
+// ValidatePlayerID validates the player identifier against the configured pattern
+func (t *Tracker) ValidatePlayerID(input string) error {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return i18n.Errorf("custom.empty_player")
+	}
+	if t.pattern != nil && !t.pattern.MatchString(input) {
+		return i18n.Errorf("custom.invalid_player", t.def.PlayerPattern)
+	}
+	return nil
+}
+
+// ResolvePlayer looks up a player using the resolve URL
+func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
+	input = strings.TrimSpace(input)
+
+	endpoint, err := execute(t.resolveURL, map[string]string{"Input": url.PathEscape(input)})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.fetch(ctx, endpoint)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.player_not_found")
+	}
+
+	id := input
+	if t.def.Resolve.ID != "" {
+		if id = lookupString(data, t.def.Resolve.ID); id == "" {
+			return nil, i18n.Wrap(fmt.Errorf("%s not found in response", t.def.Resolve.ID), "error.player_not_found")
+		}
+	}
+	name := id
+	if t.def.Resolve.Name != "" {
+		if n := lookupString(data, t.def.Resolve.Name); n != "" {
+			name = n
+		}
+	}
+
+	return &game.PlayerInfo{
+		ID:          id,
+		DisplayName: name,
+		GameType:    t.Type(),
+	}, nil
+}
+
+// GetCurrentState fetches the state URL and selects the tracked value
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
+	data, err := t.fetchState(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := lookup(data, t.def.State.Path)
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+	return game.NewState(stateVersion, valueState{Value: raw})
+}
+
+// CompareStates reports a state change when the selected value differs
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	prevValue, err := decodeValue(prev)
+	if err != nil {
+		return nil, err
+	}
+	curValue, err := decodeValue(cur)
+	if err != nil {
+		return nil, err
+	}
+	if prevValue == curValue {
+		return nil, nil
+	}
+
+	return []game.Event{{
+		Type:    game.EventStateChanged,
+		Summary: fmt.Sprintf("%s → %s", prevValue, curValue),
+	}}, nil
+}
+
+// CreateNotification renders the configured embed templates
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	data, err := t.fetchState(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	td := NotificationData{
+		PlayerID:   playerID,
+		PlayerName: playerName,
+		Changed:    change.HasEvent(game.EventStateChanged),
+		Data:       data,
+	}
+	if td.Current, err = decodeValue(change.Current); err != nil {
+		return nil, err
+	}
+	if td.Current == "" {
+		td.Current = lookupString(data, t.def.State.Path)
+	}
+	if td.Changed {
+		if td.Previous, err = decodeValue(change.Previous); err != nil {
+			return nil, err
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Color:     t.def.Embed.Color,
+		Timestamp: time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: t.def.Name,
+		},
+	}
+
+	if embed.Title, err = render(t.def.Embed.Title, td); err != nil {
+		return nil, err
+	}
+	if embed.Title == "" {
+		embed.Title = fmt.Sprintf("%s - %s", playerName, t.def.Name)
+	}
+	if embed.Description, err = render(t.def.Embed.Description, td); err != nil {
+		return nil, err
+	}
+	if embed.Description == "" && td.Changed {
+		embed.Description = fmt.Sprintf("%s → %s", td.Previous, td.Current)
+	}
+	if embed.URL, err = render(t.def.Embed.URL, td); err != nil {
+		return nil, err
+	}
+
+	thumbnail, err := render(t.def.Embed.Thumbnail, td)
+	if err != nil {
+		return nil, err
+	}
+	if thumbnail != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: thumbnail}
+	}
+
+	for _, f := range t.def.Embed.Fields {
+		name, err := render(f.Name, td)
+		if err != nil {
+			return nil, err
+		}
+		value, err := render(f.Value, td)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" || value == "" {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   name,
+			Value:  value,
+			Inline: f.Inline,
+		})
+	}
+
+	return embed, nil
+}
+
+// fetchState fetches and decodes the state URL for a player
+func (t *Tracker) fetchState(ctx context.Context, playerID string) (any, error) {
+	endpoint, err := execute(t.stateURL, map[string]string{"PlayerID": url.PathEscape(playerID)})
+	if err != nil {
+		return nil, err
+	}
+	data, err := t.fetch(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch state: %w", err)
+	}
+	return data, nil
+}
+
+// fetch performs a rate-limited GET request and decodes the JSON response
+func (t *Tracker) fetch(ctx context.Context, endpoint string) (any, error) {
+	t.mu.Lock()
+	elapsed := time.Since(t.lastRequest)
+	if elapsed < t.minInterval {
+		time.Sleep(t.minInterval - elapsed)
+	}
+	t.lastRequest = time.Now()
+	t.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return data, nil
+}
+
+// decodeValue returns the tracked value of a state as text
+func decodeValue(state *game.State) (string, error) {
+	if state == nil {
+		return "", nil
+	}
+	if legacy, ok := state.Legacy(); ok {
+		return legacy, nil
+	}
+
+	var s valueState
+	if err := state.Decode(&s); err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(s.Value))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return "", fmt.Errorf("failed to decode value: %w", err)
+	}
+	return formatValue(value), nil
+}
+
+// execute runs a parsed template and returns the result
+func execute(tmpl *template.Template, data any) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// render parses and runs a text template; empty text renders as empty
+func render(text string, data any) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	return execute(tmpl, data)
+}
@@ -0,0 +1,279 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+// fakeAPI serves a player lookup, a rating that tests can change and a search
+type fakeAPI struct {
+	mu     sync.Mutex
+	rating int
+	header string // last Authorization header seen
+}
+
+func (f *fakeAPI) setRating(rating int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rating = rating
+}
+
+func (f *fakeAPI) lastHeader() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.header
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.header = r.Header.Get("Authorization")
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/players/magnus":
+		fmt.Fprint(w, `{"username": "magnus", "name": "Magnus Carlsen"}`)
+	case r.URL.Path == "/players/magnus/stats":
+		fmt.Fprintf(w, `{"rapid": {"rating": %d, "best": 2900}}`, f.rating)
+	case r.URL.Path == "/search":
+		fmt.Fprint(w, `{"players": [
+			{"username": "magnus", "name": "Magnus Carlsen"},
+			{"username": "magnus2"},
+			{"name": "no username"},
+			{"username": "magnus3"}
+		]}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestTracker(t *testing.T, modify func(*Definition)) (*Tracker, *fakeAPI) {
+	t.Helper()
+	fake := &fakeAPI{rating: 2800}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	def := Definition{
+		Type:    "chess",
+		Name:    "Chess",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Resolve: ResolveConfig{
+			URL:  server.URL + "/players/{{.Input}}",
+			ID:   "$.username",
+			Name: "$.name",
+		},
+		State: StateConfig{
+			URL:  server.URL + "/players/{{.PlayerID}}/stats",
+			Path: "$.rapid.rating",
+		},
+		Embed: EmbedConfig{
+			Title: "{{.PlayerName}}: {{.Previous}} → {{.Current}}",
+			Fields: []FieldConfig{
+				{Name: "Best", Value: `{{path .Data "$.rapid.best"}}`},
+				{Name: "Missing", Value: `{{path .Data "$.blitz.rating"}}`},
+			},
+		},
+		Search: SearchConfig{
+			URL:     server.URL + "/search?q={{.Query}}",
+			Results: "$.players",
+			Input:   "$.username",
+			Name:    "$.name",
+		},
+		RateLimit: RateLimitConfig{Requests: 100, Per: time.Second},
+	}
+	if modify != nil {
+		modify(&def)
+	}
+
+	tracker, err := NewTracker(def)
+	if err != nil {
+		t.Fatalf("NewTracker: %v", err)
+	}
+	return tracker, fake
+}
+
+func TestResolvePlayer(t *testing.T) {
+	tracker, fake := newTestTracker(t, nil)
+
+	player, err := tracker.ResolvePlayer(context.Background(), " magnus ")
+	if err != nil {
+		t.Fatalf("ResolvePlayer: %v", err)
+	}
+	if player.ID != "magnus" || player.DisplayName != "Magnus Carlsen" || player.GameType != "chess" {
+		t.Errorf("ResolvePlayer = %+v", player)
+	}
+	if got := fake.lastHeader(); got != "Bearer token" {
+		t.Errorf("Authorization header = %q, want the configured header", got)
+	}
+
+	if _, err := tracker.ResolvePlayer(context.Background(), "nobody"); err == nil {
+		t.Error("ResolvePlayer of an unknown player: want error")
+	}
+}
+
+func TestValidatePlayerID(t *testing.T) {
+	tracker, _ := newTestTracker(t, func(def *Definition) {
+		def.PlayerPattern = `^[a-z]+$`
+	})
+
+	if err := tracker.ValidatePlayerID("magnus"); err != nil {
+		t.Errorf("ValidatePlayerID(magnus): %v", err)
+	}
+	for _, input := range []string{"", "   ", "Magnus!"} {
+		if err := tracker.ValidatePlayerID(input); err == nil {
+			t.Errorf("ValidatePlayerID(%q): want error", input)
+		}
+	}
+}
+
+func TestStateChanges(t *testing.T) {
+	ctx := context.Background()
+	tracker, fake := newTestTracker(t, nil)
+
+	first, err := tracker.GetCurrentState(ctx, "magnus")
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	same, err := tracker.GetCurrentState(ctx, "magnus")
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	events, err := tracker.CompareStates(first, same)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("events for an unchanged value = %+v, want none", events)
+	}
+
+	fake.setRating(2815)
+	changed, err := tracker.GetCurrentState(ctx, "magnus")
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	events, err = tracker.CompareStates(first, changed)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != game.EventStateChanged || events[0].Summary != "2800 → 2815" {
+		t.Fatalf("events = %+v, want one 2800 → 2815 change", events)
+	}
+
+	embed, err := tracker.CreateNotification(ctx, "magnus", "Magnus Carlsen",
+		game.StateChange{Previous: first, Current: changed, Events: events})
+	if err != nil {
+		t.Fatalf("CreateNotification: %v", err)
+	}
+	if embed.Title != "Magnus Carlsen: 2800 → 2815" {
+		t.Errorf("Title = %q", embed.Title)
+	}
+	// Fields rendering empty are left out
+	if len(embed.Fields) != 1 || embed.Fields[0].Name != "Best" || embed.Fields[0].Value != "2900" {
+		t.Errorf("Fields = %+v, want only Best = 2900", embed.Fields)
+	}
+}
+
+func TestLegacyStateComparison(t *testing.T) {
+	tracker, _ := newTestTracker(t, nil)
+
+	current, err := tracker.GetCurrentState(context.Background(), "magnus")
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	events, err := tracker.CompareStates(game.LegacyState("2800"), current)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("events = %+v, want none for the same value stored in the legacy format", events)
+	}
+}
+
+func TestMissingStateValue(t *testing.T) {
+	tracker, _ := newTestTracker(t, func(def *Definition) {
+		def.State.Path = "$.blitz.rating"
+	})
+
+	state, err := tracker.GetCurrentState(context.Background(), "magnus")
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	if state != nil {
+		t.Errorf("state = %+v, want nil when the path is missing", state)
+	}
+}
+
+func TestSearchPlayers(t *testing.T) {
+	tracker, _ := newTestTracker(t, nil)
+	searchable := &searchableTracker{tracker}
+
+	results, err := searchable.SearchPlayers(context.Background(), "mag", 2)
+	if err != nil {
+		t.Fatalf("SearchPlayers: %v", err)
+	}
+	want := []game.SearchResult{
+		{Input: "magnus", Name: "Magnus Carlsen"},
+		{Input: "magnus2", Name: "magnus2"},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("SearchPlayers = %+v, want %+v", results, want)
+	}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Errorf("result %d = %+v, want %+v", i, results[i], want[i])
+		}
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	tracker, _ := newTestTracker(t, func(def *Definition) {
+		def.State.URL = strings.Replace(def.State.URL, "/stats", "/missing", 1)
+	})
+
+	_, err := tracker.GetCurrentState(context.Background(), "magnus")
+	if err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("GetCurrentState error = %v, want a 404 API error", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	tracker, _ := newTestTracker(t, func(def *Definition) {
+		def.RateLimit = RateLimitConfig{Requests: 1, Per: 100 * time.Millisecond}
+	})
+
+	start := time.Now()
+	for range 3 {
+		if _, err := tracker.GetCurrentState(context.Background(), "magnus"); err != nil {
+			t.Fatalf("GetCurrentState: %v", err)
+		}
+	}
+	// The first request goes out right away, each later one waits an interval
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("3 requests took %v, want at least 200ms at 1 request per 100ms", elapsed)
+	}
+}
+
+func TestRateLimitInterval(t *testing.T) {
+	tests := []struct {
+		limit RateLimitConfig
+		want  time.Duration
+	}{
+		{RateLimitConfig{}, time.Second},
+		{RateLimitConfig{Requests: 5}, time.Second},
+		{RateLimitConfig{Requests: 4, Per: time.Second}, 250 * time.Millisecond},
+		{RateLimitConfig{Requests: 1, Per: time.Minute}, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := tt.limit.interval(); got != tt.want {
+			t.Errorf("%+v.interval() = %v, want %v", tt.limit, got, tt.want)
+		}
+	}
+}
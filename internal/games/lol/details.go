@@ -0,0 +1,222 @@
+package lol
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+// maxRecentMatches caps how many matches RecentMatches fetches
+const maxRecentMatches = 10
+
+// kst is the timezone match times are shown in
+var kst = time.FixedZone("KST", 9*60*60)
+
+// StateMatchID returns the latest match recorded in a state
+func (t *Tracker) StateMatchID(state *game.State) string {
+	s, err := decodeState(state)
+	if err != nil {
+		return ""
+	}
+	return s.MatchID
+}
+
+// Scoreboard returns an embed with both teams of a match
+func (t *Tracker) Scoreboard(ctx context.Context, matchID string) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	match, err := t.client.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.match_unavailable")
+	}
+
+	minutes := match.Info.GameDuration / 60
+	seconds := match.Info.GameDuration % 60
+
+	embed := &discordgo.MessageEmbed{
+		Title:       l.T("lol.scoreboard"),
+		Description: fmt.Sprintf("%s | %d:%02d", queueName(l, match.Info.QueueID), minutes, seconds),
+		Color:       0x3498DB,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("lol.match_id", match.Metadata.MatchID),
+		},
+		Timestamp: time.UnixMilli(match.Info.GameEndTimestamp).Format(time.RFC3339),
+	}
+
+	for _, team := range []struct {
+		id   int
+		name string
+	}{{100, l.T("lol.blue_team")}, {200, l.T("lol.red_team")}} {
+		var lines []string
+		result := l.T("lol.defeat")
+		kills := 0
+		for _, p := range match.Info.Participants {
+			if p.TeamID != team.id {
+				continue
+			}
+			if p.Win {
+				result = l.T("lol.victory")
+			}
+			kills += p.Kills
+			lines = append(lines, fmt.Sprintf("`%s` %s · %d/%d/%d · CS %d · %s",
+				p.ChampionName, participantName(&p), p.Kills, p.Deaths, p.Assists,
+				p.TotalMinionsKilled+p.NeutralMinionsKilled, formatNumber(p.TotalDamageDealtToChampions)))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("lol.team_summary", team.name, result, kills),
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+
+	return embed, nil
+}
+
+// RecentMatches returns an embed summarizing a player's last n matches
+func (t *Tracker) RecentMatches(ctx context.Context, playerID, playerName string, n int) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	n = min(max(n, 1), maxRecentMatches)
+	matchIDs, err := t.client.GetMatchIDsByPUUID(ctx, playerID, n)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.matches_unavailable")
+	}
+
+	var lines []string
+	wins, played := 0, 0
+	kda := 0.0
+	for _, matchID := range matchIDs {
+		match, err := t.client.GetMatch(ctx, matchID)
+		if err != nil {
+			return nil, i18n.Wrap(err, "error.match_unavailable")
+		}
+		p := match.FindParticipant(playerID)
+		if p == nil {
+			continue
+		}
+
+		played++
+		result := l.T("lol.loss_short")
+		if p.Win {
+			wins++
+			result = l.T("lol.win_short")
+		}
+		ratio := float64(p.Kills+p.Assists) / float64(max(p.Deaths, 1))
+		kda += ratio
+		lines = append(lines, fmt.Sprintf("%s · **%s** %d/%d/%d (%.2f) · %s · %s",
+			result, p.ChampionName, p.Kills, p.Deaths, p.Assists, ratio,
+			queueName(l, match.Info.QueueID),
+			time.UnixMilli(match.Info.GameEndTimestamp).In(kst).Format("01/02 15:04")))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: l.T("lol.recent_title", played),
+		Color: 0x3498DB,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+	}
+	if played == 0 {
+		embed.Description = l.T("lol.recent_none")
+		return embed, nil
+	}
+
+	embed.Description = l.T("lol.recent_summary", wins, played-wins, float64(wins)/float64(played)*100, kda/float64(played)) +
+		"\n\n" + strings.Join(lines, "\n")
+	return embed, nil
+}
+
+// LiveStatus returns an embed with both teams of the game the player is in,
+// or nil if they are not playing
+func (t *Tracker) LiveStatus(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	current, err := t.client.GetCurrentGame(ctx, playerID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.match_unavailable")
+	}
+	if current == nil {
+		return nil, nil
+	}
+
+	status := l.T("lol.live_loading")
+	if current.GameStartTime > 0 {
+		status = l.T("lol.live_elapsed", int(time.Since(time.UnixMilli(current.GameStartTime)).Minutes()))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       l.T("lol.live_title"),
+		Description: fmt.Sprintf("%s | %s", queueName(l, current.GameQueueConfigID), status),
+		Color:       0xE74C3C,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+	}
+	if p := current.FindParticipant(playerID); p != nil {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{
+			URL: fmt.Sprintf("https://raw.communitydragon.org/latest/plugins/rcp-be-lol-game-data/global/default/v1/champion-icons/%d.png", p.ChampionID),
+		}
+	}
+
+	for _, team := range []struct {
+		id   int
+		name string
+	}{{100, l.T("lol.blue_team")}, {200, l.T("lol.red_team")}} {
+		var lines []string
+		for _, p := range current.Participants {
+			if p.TeamID != team.id {
+				continue
+			}
+			if p.PUUID == playerID {
+				lines = append(lines, fmt.Sprintf("**%s**", p.RiotID))
+			} else {
+				lines = append(lines, p.RiotID)
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   team.name,
+			Value:  strings.Join(lines, "\n"),
+			Inline: true,
+		})
+	}
+
+	return embed, nil
+}
+
+// ProfileURL returns the player's OP.GG page
+func (t *Tracker) ProfileURL(playerID, playerName string) string {
+	return fmt.Sprintf("https://op.gg/lol/summoners/kr/%s", url.PathEscape(strings.Replace(playerName, "#", "-", 1)))
+}
+
+// participantName returns a participant's Riot ID, falling back to the summoner name
+func participantName(p *riot.Participant) string {
+	if p.RiotIdGameName != "" {
+		return fmt.Sprintf("%s#%s", p.RiotIdGameName, p.RiotIdTagline)
+	}
+	return p.SummonerName
+}
+
+// PlayerProfile summarizes the player's solo queue standing and season record
+func (t *Tracker) PlayerProfile(ctx context.Context, playerID, playerName string) (string, error) {
+	l := i18n.FromContext(ctx)
+	entries, err := t.client.GetLeagueEntries(ctx, playerID)
+	if err != nil {
+		return "", i18n.Wrap(err, "error.player_not_found")
+	}
+	for _, entry := range entries {
+		if entry.QueueType == riot.SoloQueue {
+			standing := game.Standing{Tier: entry.Tier, Division: entry.Rank, LP: entry.LeaguePoints}
+			return l.T("profile.ranked", l.T("lol.solo_rank"), standing, entry.Wins, entry.Losses, entry.WinRate()), nil
+		}
+	}
+	return l.T("profile.unranked", l.T("lol.solo_rank")), nil
+}
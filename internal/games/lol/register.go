@@ -0,0 +1,18 @@
+package lol
+
+import (
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+func init() {
+	game.RegisterFactory(game.Factory{
+		Type:         game.GameTypeLoL,
+		Name:         "리그 오브 레전드",
+		RequiredKeys: []string{"RIOT_API_KEY"},
+		New: func(cfg *config.Config) (game.Tracker, error) {
+			return NewTrackerWithClient(riot.SharedClient(cfg.RiotAPIKey)), nil
+		},
+	})
+}
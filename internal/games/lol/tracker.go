@@ -3,11 +3,13 @@ package lol
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
 	"github.com/flor3z/discord-bot/internal/riot"
 )
 
@@ -18,8 +20,13 @@ type Tracker struct {
 
 // NewTracker creates a new LoL tracker
 func NewTracker(apiKey string) *Tracker {
+	return NewTrackerWithClient(riot.NewClient(apiKey))
+}
+
+// NewTrackerWithClient creates a LoL tracker using the given Riot client
+func NewTrackerWithClient(client *riot.Client) *Tracker {
 	return &Tracker{
-		client: riot.NewClient(apiKey),
+		client: client,
 	}
 }
 
@@ -42,14 +49,14 @@ func (t *Tracker) Description() string {
 func (t *Tracker) ValidatePlayerID(input string) error {
 	parts := strings.Split(input, "#")
 	if len(parts) != 2 {
-		return fmt.Errorf("잘못된 형식: 소환사명#태그 형식이어야 합니다 (예: Faker#KR1)")
+		return i18n.Errorf("lol.invalid_format")
 	}
 
 	gameName := strings.TrimSpace(parts[0])
 	tagLine := strings.TrimSpace(parts[1])
 
 	if gameName == "" || tagLine == "" {
-		return fmt.Errorf("소환사명과 태그는 비워둘 수 없습니다")
+		return i18n.Errorf("lol.empty_name")
 	}
 
 	return nil
@@ -59,7 +66,7 @@ func (t *Tracker) ValidatePlayerID(input string) error {
 func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
 	parts := strings.Split(input, "#")
 	if len(parts) != 2 {
-		return nil, fmt.Errorf("잘못된 Riot ID 형식")
+		return nil, i18n.Errorf("lol.invalid_riot_id")
 	}
 
 	gameName := strings.TrimSpace(parts[0])
@@ -67,7 +74,7 @@ func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.Player
 
 	account, err := t.client.GetAccountByRiotID(ctx, gameName, tagLine)
 	if err != nil {
-		return nil, fmt.Errorf("플레이어를 찾을 수 없습니다: %w", err)
+		return nil, i18n.Wrap(err, "error.player_not_found")
 	}
 
 	return &game.PlayerInfo{
@@ -77,49 +84,264 @@ func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.Player
 	}, nil
 }
 
-// GetCurrentState returns the latest match ID for change detection
-func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (string, error) {
+// stateVersion is the current version of matchState
+// Version 2 added the solo queue rank
+const stateVersion = 2
+
+// matchState is the tracked state of a LoL player
+type matchState struct {
+	MatchID string     `json:"match_id"`
+	Rank    *rankState `json:"rank,omitempty"`
+}
+
+// rankState is a snapshot of a player's solo queue standing
+type rankState struct {
+	Tier string `json:"tier"`
+	Rank string `json:"rank"`
+	LP   int    `json:"lp"`
+}
+
+// String formats the rank as e.g. "GOLD II 45LP"
+func (r *rankState) String() string {
+	return fmt.Sprintf("%s %s %dLP", r.Tier, r.Rank, r.LP)
+}
+
+// decodeState decodes a stored state, upgrading legacy match ID strings
+func decodeState(state *game.State) (matchState, error) {
+	if matchID, ok := state.Legacy(); ok {
+		return matchState{MatchID: matchID}, nil
+	}
+
+	var s matchState
+	if err := state.Decode(&s); err != nil {
+		return matchState{}, err
+	}
+	return s, nil
+}
+
+// GetCurrentState returns the latest match ID and solo queue standing
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
 	matchIDs, err := t.client.GetMatchIDsByPUUID(ctx, playerID, 1)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if len(matchIDs) == 0 {
-		return "", nil
+		return nil, nil
+	}
+
+	state := matchState{MatchID: matchIDs[0]}
+
+	// Rank is best-effort; unranked players simply have no entry
+	entries, err := t.client.GetLeagueEntries(ctx, playerID)
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to get league entries", "puuid", playerID, "error", err)
+	}
+	for _, entry := range entries {
+		if entry.QueueType == riot.SoloQueue {
+			state.Rank = &rankState{Tier: entry.Tier, Rank: entry.Rank, LP: entry.LeaguePoints}
+		}
+	}
+
+	return game.NewState(stateVersion, state)
+}
+
+// CompareStates reports a completed match when the latest match ID changes,
+// and any tier/division change, with or without a new match
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	before, err := decodeState(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeState(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []game.Event
+	if after.MatchID != "" && after.MatchID != before.MatchID {
+		events = append(events, game.Event{Type: game.EventMatchCompleted, Summary: after.MatchID})
+	}
+
+	// Rank is compared on its own: the league API often updates a poll after the match appears
+	if before.Rank != nil && after.Rank != nil &&
+		(before.Rank.Tier != after.Rank.Tier || before.Rank.Rank != after.Rank.Rank) {
+		events = append(events, game.Event{
+			Type:    game.EventRankChanged,
+			Summary: fmt.Sprintf("%s %s → %s %s", before.Rank.Tier, before.Rank.Rank, after.Rank.Tier, after.Rank.Rank),
+		})
 	}
 
-	return matchIDs[0], nil
+	return events, nil
+}
+
+// StateStanding returns the solo queue standing recorded in a state
+func (t *Tracker) StateStanding(state *game.State) (*game.Standing, error) {
+	s, err := decodeState(state)
+	if err != nil || s.Rank == nil {
+		return nil, err
+	}
+	return &game.Standing{Tier: s.Rank.Tier, Division: s.Rank.Rank, LP: s.Rank.LP}, nil
 }
 
 // CreateNotification fetches match details and creates a Discord embed
-func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName, stateID string) (*discordgo.MessageEmbed, error) {
-	// stateID is the match ID for LoL
-	match, err := t.client.GetMatch(ctx, stateID)
+// in the context's locale
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	state, err := decodeState(change.Current)
+	if err != nil {
+		return nil, err
+	}
+
+	// A rank update arriving after its match gets a notification of its own
+	if !change.HasEvent(game.EventMatchCompleted) {
+		return t.rankEmbed(l, playerName, state, change), nil
+	}
+
+	match, err := t.client.GetMatch(ctx, state.MatchID)
 	if err != nil {
-		return nil, fmt.Errorf("경기 정보를 가져올 수 없습니다: %w", err)
+		return nil, i18n.Wrap(err, "error.match_unavailable")
 	}
 
 	// Find the player in the match by PUUID
 	participant := match.FindParticipant(playerID)
 	if participant == nil {
 		return &discordgo.MessageEmbed{
-			Title:       "경기 결과",
-			Description: "경기 데이터에서 플레이어를 찾을 수 없습니다",
+			Title:       l.T("lol.match_result"),
+			Description: l.T("lol.player_not_in_match"),
 			Color:       0xFF0000,
 		}, nil
 	}
 
-	return createMatchEmbed(playerName, match, participant), nil
+	embed := createMatchEmbed(l, playerName, match, participant)
+
+	if state.Rank != nil {
+		value := state.Rank.String()
+		if change.Previous != nil {
+			if prev, err := decodeState(change.Previous); err == nil && prev.Rank != nil &&
+				prev.Rank.Tier == state.Rank.Tier && prev.Rank.Rank == state.Rank.Rank {
+				value += fmt.Sprintf(" (%+d)", state.Rank.LP-prev.Rank.LP)
+			}
+		}
+		for _, event := range change.Events {
+			if event.Type == game.EventRankChanged {
+				value += fmt.Sprintf("\n%s", event.Summary)
+			}
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("lol.solo_rank"),
+			Value:  value,
+			Inline: false,
+		})
+	}
+
+	return embed, nil
+}
+
+// MatchResults returns the matches completed since the previous state for
+// recap history, including games played between polls
+func (t *Tracker) MatchResults(ctx context.Context, playerID string, change game.StateChange) ([]game.MatchResult, error) {
+	state, err := decodeState(change.Current)
+	if err != nil {
+		return nil, err
+	}
+	var previous matchState
+	if change.Previous != nil {
+		if previous, err = decodeState(change.Previous); err != nil {
+			return nil, err
+		}
+	}
+
+	matchIDs, err := t.client.GetMatchIDsByPUUID(ctx, playerID, game.MaxRecordedMatches)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []game.MatchResult
+	for _, matchID := range game.NewMatchIDs(matchIDs, previous.MatchID, state.MatchID) {
+		result, ok, err := t.matchResult(ctx, playerID, matchID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to get match result", "matchID", matchID, "error", err)
+			continue
+		}
+		if ok {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// matchResult returns a player's result in one match; ok is false if they didn't play in it
+func (t *Tracker) matchResult(ctx context.Context, playerID, matchID string) (result game.MatchResult, ok bool, err error) {
+	match, err := t.client.GetMatch(ctx, matchID)
+	if err != nil {
+		return result, false, fmt.Errorf("failed to get match: %w", err)
+	}
+
+	p := match.FindParticipant(playerID)
+	if p == nil {
+		return result, false, nil
+	}
+
+	result = game.MatchResult{
+		MatchID:   matchID,
+		Win:       p.Win,
+		Kills:     p.Kills,
+		Deaths:    p.Deaths,
+		Assists:   p.Assists,
+		Character: p.ChampionName,
+		PlayedAt:  time.UnixMilli(match.Info.GameEndTimestamp),
+	}
+	if p.PentaKills > 0 {
+		result.Highlights = append(result.Highlights, game.Event{
+			Type:    game.EventPentakill,
+			Summary: i18n.FromContext(ctx).T("lol.pentakill_count", p.PentaKills, p.ChampionName),
+		})
+	}
+	return result, true, nil
+}
+
+// rankEmbed creates the notification of a tier or division change without a new match
+func (t *Tracker) rankEmbed(l i18n.Locale, playerName string, state matchState, change game.StateChange) *discordgo.MessageEmbed {
+	color := 0xE74C3C // Red for demotions
+	if game.Promoted(t, change) {
+		color = 0x2ECC71 // Green for promotions
+	}
+
+	var summaries []string
+	for _, event := range change.Events {
+		if event.Type == game.EventRankChanged {
+			summaries = append(summaries, event.Summary)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: l.T("lol.rank_changed"),
+		Color: color,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+		Description: strings.Join(summaries, "\n"),
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	if state.Rank != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("lol.solo_rank"),
+			Value:  state.Rank.String(),
+			Inline: false,
+		})
+	}
+	return embed
 }
 
 // createMatchEmbed creates a Discord embed for match notification
-func createMatchEmbed(playerName string, match *riot.Match, p *riot.Participant) *discordgo.MessageEmbed {
+func createMatchEmbed(l i18n.Locale, playerName string, match *riot.Match, p *riot.Participant) *discordgo.MessageEmbed {
 	// Determine color based on win/loss
 	color := 0xE74C3C // Red for loss
-	resultText := "패배"
+	resultText := l.T("lol.defeat")
 	if p.Win {
 		color = 0x2ECC71 // Green for win
-		resultText = "승리"
+		resultText = l.T("lol.victory")
 	}
 
 	// Calculate KDA
@@ -134,7 +356,12 @@ func createMatchEmbed(playerName string, match *riot.Match, p *riot.Participant)
 	durationStr := fmt.Sprintf("%d:%02d", minutes, seconds)
 
 	// Queue name
-	queueName := riot.GetQueueName(match.Info.QueueID)
+	queueName := queueName(l, match.Info.QueueID)
+
+	description := fmt.Sprintf("**%s** | %s", p.ChampionName, queueName)
+	if p.PentaKills > 0 {
+		description += " | " + l.T("lol.pentakill")
+	}
 
 	// Build embed
 	embed := &discordgo.MessageEmbed{
@@ -143,7 +370,7 @@ func createMatchEmbed(playerName string, match *riot.Match, p *riot.Participant)
 		Author: &discordgo.MessageEmbedAuthor{
 			Name: playerName,
 		},
-		Description: fmt.Sprintf("**%s** | %s", p.ChampionName, queueName),
+		Description: description,
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "KDA",
@@ -156,28 +383,28 @@ func createMatchEmbed(playerName string, match *riot.Match, p *riot.Participant)
 				Inline: true,
 			},
 			{
-				Name:   "피해량",
+				Name:   l.T("lol.damage"),
 				Value:  formatNumber(p.TotalDamageDealtToChampions),
 				Inline: true,
 			},
 			{
-				Name:   "골드",
+				Name:   l.T("lol.gold"),
 				Value:  formatNumber(p.GoldEarned),
 				Inline: true,
 			},
 			{
-				Name:   "시야 점수",
+				Name:   l.T("lol.vision"),
 				Value:  fmt.Sprintf("%d", p.VisionScore),
 				Inline: true,
 			},
 			{
-				Name:   "경기 시간",
+				Name:   l.T("lol.duration"),
 				Value:  durationStr,
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("경기 ID: %s", match.Metadata.MatchID),
+			Text: l.T("lol.match_id", match.Metadata.MatchID),
 		},
 		Timestamp: time.UnixMilli(match.Info.GameEndTimestamp).Format(time.RFC3339),
 	}
@@ -185,6 +412,18 @@ func createMatchEmbed(playerName string, match *riot.Match, p *riot.Participant)
 	return embed
 }
 
+// queueName returns the localized name of a queue, falling back to the
+// Riot API's English name for queues the catalog doesn't know
+func queueName(l i18n.Locale, queueID int) string {
+	if name, ok := l.Lookup(fmt.Sprintf("queue.%d", queueID)); ok {
+		return name
+	}
+	if name := riot.GetQueueName(queueID); name != "Custom Game" {
+		return name
+	}
+	return l.T("queue.custom")
+}
+
 // formatNumber formats large numbers with commas
 func formatNumber(n int) string {
 	if n < 1000 {
@@ -0,0 +1,42 @@
+package lol
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// starterIcons is the number of profile icons every account owns (IDs 0 to 28)
+const starterIcons = 29
+
+// NewChallenge asks the owner to set a starter profile icon other than their current one
+func (t *Tracker) NewChallenge(ctx context.Context, playerID string) (*game.Challenge, error) {
+	summoner, err := t.client.GetSummonerByPUUID(ctx, playerID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.player_not_found")
+	}
+
+	icon := rand.IntN(starterIcons)
+	if icon == summoner.ProfileIconID {
+		icon = (icon + 1) % starterIcons
+	}
+
+	return &game.Challenge{
+		Token:       strconv.Itoa(icon),
+		Description: i18n.FromContext(ctx).T("lol.verify_icon", icon),
+		ImageURL:    fmt.Sprintf("https://raw.communitydragon.org/latest/plugins/rcp-be-lol-game-data/global/default/v1/profile-icons/%d.jpg", icon),
+	}, nil
+}
+
+// VerifyChallenge reports whether the player's profile icon is the requested one
+func (t *Tracker) VerifyChallenge(ctx context.Context, playerID string, challenge *game.Challenge) (bool, error) {
+	summoner, err := t.client.GetSummonerByPUUID(ctx, playerID)
+	if err != nil {
+		return false, i18n.Wrap(err, "error.player_not_found")
+	}
+	return strconv.Itoa(summoner.ProfileIconID) == challenge.Token, nil
+}
@@ -0,0 +1,321 @@
+package maplestory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+)
+
+// Character profile pages shown by the /캐릭터 command
+const (
+	PageBasic = iota
+	PageStat
+	PageEquipment
+	PageSymbol
+	PageAbility
+	PageGuild
+
+	// CharacterPageCount is the total number of character profile pages
+	CharacterPageCount
+)
+
+// pageTitles are the catalog keys of the character profile page titles
+var pageTitles = [CharacterPageCount]string{
+	PageBasic:     "maple.page.basic",
+	PageStat:      "maple.page.stat",
+	PageEquipment: "maple.page.equipment",
+	PageSymbol:    "maple.page.symbol",
+	PageAbility:   "maple.page.ability",
+	PageGuild:     "maple.page.guild",
+}
+
+// mainStats are the final stats shown on the stat page, in display order
+// The names are those of the Nexon API, which only answers in Korean
+var mainStats = []string{
+	"전투력",
+	"최대 스탯공격력",
+	"STR", "DEX", "INT", "LUK",
+	"HP", "MP",
+	"데미지", "보스 몬스터 데미지", "방어율 무시",
+	"크리티컬 확률", "크리티컬 데미지",
+	"재사용 대기시간 감소 (%)",
+	"아이템 드롭률", "메소 획득량",
+	"스타포스", "아케인포스", "어센틱포스",
+}
+
+// CreateCharacterEmbed builds one page of the character profile embed
+func (t *Tracker) CreateCharacterEmbed(ctx context.Context, ocid string, page int) (*discordgo.MessageEmbed, error) {
+	if page < 0 || page >= CharacterPageCount {
+		return nil, fmt.Errorf("invalid character page %d", page)
+	}
+
+	l := i18n.FromContext(ctx)
+	basic, err := t.client.GetCharacterBasic(ctx, ocid)
+	if err != nil {
+		return nil, i18n.Wrap(err, "maple.character_unavailable")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("%s · %s", basic.CharacterName, l.T(pageTitles[page])),
+		Color: 0xFF9900, // Orange color for MapleStory
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: fmt.Sprintf("%s | Lv.%d %s", basic.WorldName, basic.CharacterLevel, basic.CharacterClass),
+		},
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: basic.CharacterImage,
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("maple.page_footer", page+1, CharacterPageCount),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	switch page {
+	case PageBasic:
+		err = t.fillBasicPage(ctx, l, embed, ocid, basic)
+	case PageStat:
+		err = t.fillStatPage(ctx, l, embed, ocid)
+	case PageEquipment:
+		err = t.fillEquipmentPage(ctx, l, embed, ocid)
+	case PageSymbol:
+		err = t.fillSymbolPage(ctx, l, embed, ocid)
+	case PageAbility:
+		err = t.fillAbilityPage(ctx, l, embed, ocid)
+	case PageGuild:
+		err = t.fillGuildPage(ctx, l, embed, basic)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return embed, nil
+}
+
+func (t *Tracker) fillBasicPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, ocid string, basic *nexon.CharacterBasic) error {
+	// The basic page shows the full character image instead of a thumbnail
+	embed.Image = &discordgo.MessageEmbedImage{URL: basic.CharacterImage}
+	embed.Thumbnail = nil
+
+	guild := basic.CharacterGuildName
+	if guild == "" {
+		guild = "-"
+	}
+
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: l.T("maple.world"), Value: basic.WorldName, Inline: true},
+		{Name: l.T("maple.class"), Value: l.T("maple.class_value", basic.CharacterClass, basic.CharacterClassLevel), Inline: true},
+		{Name: l.T("maple.gender"), Value: basic.CharacterGender, Inline: true},
+		{Name: l.T("maple.level"), Value: fmt.Sprintf("%d (%s%%)", basic.CharacterLevel, basic.CharacterExpRate), Inline: true},
+		{Name: l.T("maple.page.guild"), Value: guild, Inline: true},
+		{Name: l.T("maple.created"), Value: formatDate(basic.CharacterDateCreate), Inline: true},
+	}
+
+	if popularity, err := t.client.GetCharacterPopularity(ctx, ocid); err == nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: l.T("maple.popularity"), Value: fmt.Sprintf("%d", popularity.Popularity), Inline: true,
+		})
+	}
+
+	if union, err := t.client.GetUserUnion(ctx, ocid); err == nil && union.UnionLevel > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: l.T("maple.union"), Value: fmt.Sprintf("%s (Lv.%d)", union.UnionGrade, union.UnionLevel), Inline: true,
+		})
+	}
+
+	return nil
+}
+
+func (t *Tracker) fillStatPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, ocid string) error {
+	stat, err := t.client.GetCharacterStat(ctx, ocid)
+	if err != nil {
+		return i18n.Wrap(err, "maple.stat_unavailable")
+	}
+
+	for _, name := range mainStats {
+		value, ok := stat.Find(name)
+		if !ok || value == "" {
+			continue
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: name, Value: value, Inline: true,
+		})
+	}
+
+	hyper, err := t.client.GetCharacterHyperStat(ctx, ocid)
+	if err != nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	for _, h := range hyper.ActivePreset() {
+		if h.StatLevel == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("Lv.%d %s\n", h.StatLevel, h.StatIncrease))
+	}
+	if sb.Len() > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("maple.hyper_stat", hyper.UsePresetNo),
+			Value: truncate(sb.String(), 1024),
+		})
+	}
+
+	return nil
+}
+
+func (t *Tracker) fillEquipmentPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, ocid string) error {
+	equipment, err := t.client.GetCharacterItemEquipment(ctx, ocid)
+	if err != nil {
+		return i18n.Wrap(err, "maple.equipment_unavailable")
+	}
+
+	var sb strings.Builder
+	for _, item := range equipment.ItemEquipment {
+		sb.WriteString(fmt.Sprintf("**%s** %s", item.ItemEquipmentSlot, item.ItemName))
+		if item.Starforce != "" && item.Starforce != "0" {
+			sb.WriteString(fmt.Sprintf(" ⭐%s", item.Starforce))
+		}
+		if item.PotentialOptionGrade != "" {
+			sb.WriteString(fmt.Sprintf(" · %s", item.PotentialOptionGrade))
+		}
+		if item.AdditionalPotentialOptionGrade != "" {
+			sb.WriteString(fmt.Sprintf(" / %s", item.AdditionalPotentialOptionGrade))
+		}
+		sb.WriteString("\n")
+	}
+
+	if sb.Len() == 0 {
+		embed.Description = l.T("maple.no_equipment")
+		return nil
+	}
+
+	embed.Description = truncate(sb.String(), 4096)
+	return nil
+}
+
+func (t *Tracker) fillSymbolPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, ocid string) error {
+	symbols, err := t.client.GetCharacterSymbolEquipment(ctx, ocid)
+	if err != nil {
+		return i18n.Wrap(err, "maple.symbol_unavailable")
+	}
+
+	if len(symbols.Symbol) == 0 {
+		embed.Description = l.T("maple.no_symbols")
+		return nil
+	}
+
+	for _, symbol := range symbols.Symbol {
+		progress := "MAX"
+		if symbol.SymbolRequireGrowthCount > 0 {
+			progress = fmt.Sprintf("%d/%d", symbol.SymbolGrowthCount, symbol.SymbolRequireGrowthCount)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   strings.TrimPrefix(strings.TrimPrefix(symbol.SymbolName, "아케인심볼 : "), "어센틱심볼 : "),
+			Value:  l.T("maple.symbol_value", symbol.SymbolLevel, symbol.SymbolForce) + "\n" + progress,
+			Inline: true,
+		})
+	}
+
+	return nil
+}
+
+func (t *Tracker) fillAbilityPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, ocid string) error {
+	ability, err := t.client.GetCharacterAbility(ctx, ocid)
+	if err != nil {
+		return i18n.Wrap(err, "maple.ability_unavailable")
+	}
+
+	var sb strings.Builder
+	for _, info := range ability.AbilityInfo {
+		sb.WriteString(fmt.Sprintf("[%s] %s\n", info.AbilityGrade, info.AbilityValue))
+	}
+	if sb.Len() > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("maple.ability", ability.AbilityGrade),
+			Value: sb.String(),
+		})
+	}
+
+	links, err := t.client.GetCharacterLinkSkill(ctx, ocid)
+	if err != nil {
+		return nil
+	}
+
+	sb.Reset()
+	for _, skill := range links.CharacterLinkSkill {
+		sb.WriteString(fmt.Sprintf("%s Lv.%d\n", skill.SkillName, skill.SkillLevel))
+	}
+	if sb.Len() > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("maple.link_skills"),
+			Value: truncate(sb.String(), 1024),
+		})
+	}
+
+	if len(embed.Fields) == 0 {
+		embed.Description = l.T("maple.no_ability")
+	}
+
+	return nil
+}
+
+func (t *Tracker) fillGuildPage(ctx context.Context, l i18n.Locale, embed *discordgo.MessageEmbed, basic *nexon.CharacterBasic) error {
+	guild, err := t.client.GetCharacterGuild(ctx, basic)
+	if err != nil {
+		return i18n.Wrap(err, "maple.guild_unavailable")
+	}
+
+	if guild == nil {
+		embed.Description = l.T("maple.no_guild")
+		return nil
+	}
+
+	// Custom guild marks are base64 blobs, which embeds cannot display
+	if strings.HasPrefix(guild.GuildMark, "http") {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: guild.GuildMark}
+	}
+
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: l.T("maple.guild_name"), Value: guild.GuildName, Inline: true},
+		{Name: l.T("maple.level"), Value: fmt.Sprintf("%d", guild.GuildLevel), Inline: true},
+		{Name: l.T("maple.guild_master"), Value: guild.GuildMasterName, Inline: true},
+		{Name: l.T("maple.guild_members"), Value: l.T("maple.member_count", guild.GuildMemberCount), Inline: true},
+		{Name: l.T("maple.guild_fame"), Value: fmt.Sprintf("%d", guild.GuildFame), Inline: true},
+		{Name: l.T("maple.guild_points"), Value: fmt.Sprintf("%d", guild.GuildPoint), Inline: true},
+	}
+
+	return nil
+}
+
+// formatDate trims an API timestamp such as "2023-12-21T00:00+09:00" to its date
+func formatDate(value string) string {
+	if t, err := time.Parse("2006-01-02T15:04-07:00", value); err == nil {
+		return t.Format("2006-01-02")
+	}
+	if len(value) >= 10 {
+		return value[:10]
+	}
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// truncate shortens a string to fit within a Discord embed length limit
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
+// PlayerStats returns the stat page of the character profile
+func (t *Tracker) PlayerStats(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error) {
+	return t.CreateCharacterEmbed(ctx, playerID, PageStat)
+}
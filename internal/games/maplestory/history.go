@@ -0,0 +1,57 @@
+package maplestory
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+)
+
+// DailySnapshot is a character's level and EXP as of the end of a day
+type DailySnapshot struct {
+	Date    string // YYYY-MM-DD in KST
+	Level   int64
+	Exp     int64
+	ExpRate float64
+}
+
+// GetDailySnapshot fetches the character's progress as of the end of the given day
+func (t *Tracker) GetDailySnapshot(ctx context.Context, ocid string, date time.Time) (*DailySnapshot, error) {
+	basicInfo, err := t.client.GetCharacterBasicAt(ctx, ocid, date)
+	if err != nil {
+		return nil, i18n.Wrap(err, "maple.snapshot_unavailable", nexon.FormatDate(date))
+	}
+
+	// The API returns an empty body for days before the character existed
+	if basicInfo.CharacterLevel == 0 {
+		return nil, i18n.Errorf("maple.snapshot_empty", nexon.FormatDate(date))
+	}
+
+	rate, _ := strconv.ParseFloat(basicInfo.CharacterExpRate, 64)
+
+	return &DailySnapshot{
+		Date:    nexon.FormatDate(date),
+		Level:   basicInfo.CharacterLevel,
+		Exp:     basicInfo.CharacterExp,
+		ExpRate: rate,
+	}, nil
+}
+
+// HistoryDates returns the past days (oldest first) that can be snapshotted
+// within the last n days, ending yesterday in KST
+func HistoryDates(now time.Time, n int) []time.Time {
+	today := now.In(nexon.KST)
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, nexon.KST)
+
+	dates := make([]time.Time, 0, n)
+	for d := n; d >= 1; d-- {
+		date := today.AddDate(0, 0, -d)
+		if date.Before(nexon.FirstHistoryDate) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
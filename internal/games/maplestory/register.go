@@ -0,0 +1,17 @@
+package maplestory
+
+import (
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+func init() {
+	game.RegisterFactory(game.Factory{
+		Type:         game.GameTypeMaplestory,
+		Name:         "메이플스토리",
+		RequiredKeys: []string{"NEXON_API_KEY"},
+		New: func(cfg *config.Config) (game.Tracker, error) {
+			return NewTracker(cfg.NexonAPIKey, Options{LevelUpOnly: cfg.MaplestoryLevelUpOnly}), nil
+		},
+	})
+}
@@ -0,0 +1,255 @@
+package maplestory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+)
+
+// stateVersion is the current version of characterState
+const stateVersion = 1
+
+// combatPowerThreshold is the minimum relative combat power change worth notifying
+const combatPowerThreshold = 0.01
+
+// characterState is the structured state tracked for a character
+// Legacy states hold either "lv:{level}:exp:{exp}" or this struct as a JSON string
+type characterState struct {
+	Level       int64                     `json:"lv"`
+	Exp         int64                     `json:"exp"`
+	ExpRate     float64                   `json:"rate,omitempty"` // EXP percentage within the level
+	CombatPower int64                     `json:"cp,omitempty"`
+	Equipment   map[string]equipmentState `json:"eq,omitempty"` // keyed by equipment slot
+
+	// legacy is set when the state was parsed from the "lv:…:exp:…" format,
+	// in which case only level and EXP are known
+	legacy bool
+}
+
+// equipmentState is the tracked part of a single equipped item
+type equipmentState struct {
+	Name                string   `json:"n"`
+	Starforce           int      `json:"sf,omitempty"`
+	Potential           string   `json:"p,omitempty"`
+	PotentialLines      []string `json:"pl,omitempty"`
+	AdditionalPotential string   `json:"ap,omitempty"`
+	AdditionalLines     []string `json:"apl,omitempty"`
+}
+
+// newCharacterState builds a state from fresh API responses
+// stat and equipment may be nil if those endpoints failed
+func newCharacterState(basic *nexon.CharacterBasic, stat *nexon.CharacterStat, equipment *nexon.CharacterItemEquipment) characterState {
+	state := characterState{
+		Level: basic.CharacterLevel,
+		Exp:   basic.CharacterExp,
+	}
+	state.ExpRate, _ = strconv.ParseFloat(basic.CharacterExpRate, 64)
+
+	if stat != nil {
+		if value, ok := stat.Find("전투력"); ok {
+			state.CombatPower, _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+
+	if equipment != nil {
+		state.Equipment = make(map[string]equipmentState, len(equipment.ItemEquipment))
+		for _, item := range equipment.ItemEquipment {
+			starforce, _ := strconv.Atoi(item.Starforce)
+			state.Equipment[item.ItemEquipmentSlot] = equipmentState{
+				Name:                item.ItemName,
+				Starforce:           starforce,
+				Potential:           item.PotentialOptionGrade,
+				PotentialLines:      nonEmpty(item.PotentialOption1, item.PotentialOption2, item.PotentialOption3),
+				AdditionalPotential: item.AdditionalPotentialOptionGrade,
+				AdditionalLines:     nonEmpty(item.AdditionalPotentialOption1, item.AdditionalPotentialOption2, item.AdditionalPotentialOption3),
+			}
+		}
+	}
+
+	return state
+}
+
+// decodeState decodes a stored state, upgrading legacy strings
+func decodeState(state *game.State) (characterState, error) {
+	if legacy, ok := state.Legacy(); ok {
+		s, ok := parseLegacyState(legacy)
+		if !ok {
+			return characterState{}, fmt.Errorf("unrecognized legacy state: %q", legacy)
+		}
+		return s, nil
+	}
+
+	var s characterState
+	if err := state.Decode(&s); err != nil {
+		return characterState{}, err
+	}
+	return s, nil
+}
+
+// parseLegacyState parses a state string stored in summoners.last_match_id
+func parseLegacyState(state string) (characterState, bool) {
+	if strings.HasPrefix(state, "{") {
+		var s characterState
+		if err := json.Unmarshal([]byte(state), &s); err != nil {
+			return characterState{}, false
+		}
+		return s, true
+	}
+
+	// Legacy format: lv:{level}:exp:{exp}
+	parts := strings.Split(state, ":")
+	if len(parts) != 4 || parts[0] != "lv" || parts[2] != "exp" {
+		return characterState{}, false
+	}
+
+	level, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return characterState{}, false
+	}
+	exp, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return characterState{}, false
+	}
+
+	return characterState{Level: level, Exp: exp, legacy: true}, true
+}
+
+// combatPowerChange returns the relative combat power change if it is significant
+func combatPowerChange(prev, cur characterState) (float64, bool) {
+	if prev.legacy || prev.CombatPower <= 0 || cur.CombatPower <= 0 {
+		return 0, false
+	}
+	ratio := float64(cur.CombatPower-prev.CombatPower) / float64(prev.CombatPower)
+	if ratio < combatPowerThreshold && ratio > -combatPowerThreshold {
+		return 0, false
+	}
+	return ratio, true
+}
+
+// diffEquipment describes the equipment changes between two states, one line per change
+func diffEquipment(l i18n.Locale, prev, cur characterState) []string {
+	// Without a previous equipment snapshot every item would look new
+	if prev.legacy || prev.Equipment == nil || cur.Equipment == nil {
+		return nil
+	}
+
+	slots := make([]string, 0, len(cur.Equipment))
+	for slot := range cur.Equipment {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+
+	var changes []string
+	for _, slot := range slots {
+		after := cur.Equipment[slot]
+		before, ok := prev.Equipment[slot]
+
+		if !ok || before.Name != after.Name {
+			line := l.T("maple.gear_equipped", slot, after.Name)
+			if after.Starforce > 0 {
+				line += fmt.Sprintf(" (⭐%d)", after.Starforce)
+			}
+			changes = append(changes, line)
+			continue
+		}
+
+		if after.Starforce > before.Starforce {
+			changes = append(changes, l.T("maple.gear_starforce_up", slot, after.Starforce, before.Starforce, after.Starforce))
+		} else if after.Starforce < before.Starforce {
+			changes = append(changes, l.T("maple.gear_starforce_down", slot, before.Starforce, after.Starforce))
+		}
+
+		if line, changed := diffPotential(l, slot, l.T("maple.potential"), before.Potential, after.Potential, before.PotentialLines, after.PotentialLines); changed {
+			changes = append(changes, line)
+		}
+		if line, changed := diffPotential(l, slot, l.T("maple.additional_potential"), before.AdditionalPotential, after.AdditionalPotential, before.AdditionalLines, after.AdditionalLines); changed {
+			changes = append(changes, line)
+		}
+	}
+
+	var removed []string
+	for slot := range prev.Equipment {
+		if _, ok := cur.Equipment[slot]; !ok {
+			removed = append(removed, slot)
+		}
+	}
+	sort.Strings(removed)
+	for _, slot := range removed {
+		changes = append(changes, l.T("maple.gear_unequipped", slot, prev.Equipment[slot].Name))
+	}
+
+	return changes
+}
+
+// diffPotential describes a potential (or additional potential) change on one item
+func diffPotential(l i18n.Locale, slot, label, beforeGrade, afterGrade string, beforeLines, afterLines []string) (string, bool) {
+	if beforeGrade == afterGrade && strings.Join(beforeLines, "|") == strings.Join(afterLines, "|") {
+		return "", false
+	}
+
+	options := strings.Join(afterLines, " / ")
+	if options == "" {
+		options = "-"
+	}
+
+	if beforeGrade != afterGrade {
+		return l.T("maple.potential_grade", slot, label, gradeOrNone(l, beforeGrade), gradeOrNone(l, afterGrade), options), true
+	}
+	return l.T("maple.potential_reset", slot, label, gradeOrNone(l, afterGrade), options), true
+}
+
+func gradeOrNone(l i18n.Locale, grade string) string {
+	if grade == "" {
+		return l.T("maple.no_grade")
+	}
+	return grade
+}
+
+// nonEmpty returns the non-empty values in order
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// formatCombatPower formats a combat power value in units of 10,000 (e.g.
+// "1억 2345만"), or in millions in English, which has no such unit
+func formatCombatPower(l i18n.Locale, n int64) string {
+	const (
+		man = 10_000
+		eok = 100_000_000
+	)
+
+	if l == i18n.English {
+		switch {
+		case n >= 1_000_000:
+			return fmt.Sprintf("%.2fM", float64(n)/1_000_000)
+		case n >= 1_000:
+			return fmt.Sprintf("%.1fK", float64(n)/1_000)
+		default:
+			return fmt.Sprintf("%d", n)
+		}
+	}
+
+	switch {
+	case n >= eok:
+		if rest := n % eok / man; rest > 0 {
+			return l.T("maple.power_eok_man", n/eok, rest)
+		}
+		return l.T("maple.power_eok", n/eok)
+	case n >= man:
+		return l.T("maple.power_man", n/man)
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
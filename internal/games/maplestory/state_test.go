@@ -0,0 +1,101 @@
+package maplestory
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+func TestParseLegacyState(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  string
+		want   characterState
+		wantOK bool
+	}{
+		{"level and exp", "lv:250:exp:12345", characterState{Level: 250, Exp: 12345, legacy: true}, true},
+		{"json string", `{"lv":260,"exp":42,"rate":1.5,"cp":1000}`, characterState{Level: 260, Exp: 42, ExpRate: 1.5, CombatPower: 1000}, true},
+		{"bad json", `{"lv":`, characterState{}, false},
+		{"wrong prefix", "level:250:exp:1", characterState{}, false},
+		{"missing exp", "lv:250", characterState{}, false},
+		{"bad level", "lv:abc:exp:1", characterState{}, false},
+		{"bad exp", "lv:250:exp:abc", characterState{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseLegacyState(tt.state)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got.Level != tt.want.Level || got.Exp != tt.want.Exp || got.ExpRate != tt.want.ExpRate ||
+				got.CombatPower != tt.want.CombatPower || got.legacy != tt.want.legacy {
+				t.Errorf("parseLegacyState = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffEquipment(t *testing.T) {
+	l := i18n.Korean
+	gear := func(items map[string]equipmentState) characterState {
+		return characterState{Level: 260, Equipment: items}
+	}
+
+	tests := []struct {
+		name      string
+		prev, cur characterState
+		want      []string
+	}{
+		{
+			name: "legacy previous state",
+			prev: characterState{Level: 250, legacy: true},
+			cur:  gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자"}}),
+		},
+		{
+			name: "no change",
+			prev: gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 17}}),
+			cur:  gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 17}}),
+		},
+		{
+			name: "new item",
+			prev: gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자"}}),
+			cur:  gear(map[string]equipmentState{"모자": {Name: "아케인셰이드 모자", Starforce: 12}}),
+			want: []string{l.T("maple.gear_equipped", "모자", "아케인셰이드 모자") + " (⭐12)"},
+		},
+		{
+			name: "starforce",
+			prev: gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 17}, "상의": {Name: "앱솔랩스 상의", Starforce: 15}}),
+			cur:  gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 18}, "상의": {Name: "앱솔랩스 상의", Starforce: 12}}),
+			want: []string{
+				l.T("maple.gear_starforce_up", "모자", 18, 17, 18),
+				l.T("maple.gear_starforce_down", "상의", 15, 12),
+			},
+		},
+		{
+			name: "potential grade",
+			prev: gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Potential: "유니크", PotentialLines: []string{"STR +9%"}}}),
+			cur:  gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Potential: "레전드리", PotentialLines: []string{"STR +12%"}}}),
+			want: []string{l.T("maple.potential_grade", "모자", l.T("maple.potential"), "유니크", "레전드리", "STR +12%")},
+		},
+		{
+			name: "unequipped in slot order",
+			prev: gear(map[string]equipmentState{"하의": {Name: "앱솔랩스 하의"}, "망토": {Name: "앱솔랩스 망토"}, "모자": {Name: "앱솔랩스 모자"}, "벨트": {Name: "골든 클로버 벨트"}}),
+			cur:  gear(map[string]equipmentState{"모자": {Name: "앱솔랩스 모자"}}),
+			want: []string{
+				l.T("maple.gear_unequipped", "망토", "앱솔랩스 망토"),
+				l.T("maple.gear_unequipped", "벨트", "골든 클로버 벨트"),
+				l.T("maple.gear_unequipped", "하의", "앱솔랩스 하의"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffEquipment(l, tt.prev, tt.cur); !slices.Equal(got, tt.want) {
+				t.Errorf("diffEquipment =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
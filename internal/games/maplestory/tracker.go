@@ -3,23 +3,37 @@ package maplestory
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
 	"github.com/flor3z/discord-bot/internal/nexon"
 )
 
+// Options configures the MapleStory tracker
+type Options struct {
+	// LevelUpOnly suppresses every notification without a level-up, including
+	// EXP, equipment and combat power changes
+	LevelUpOnly bool
+}
+
 // Tracker implements game.Tracker for MapleStory
 type Tracker struct {
 	client *nexon.Client
+	opts   Options
 }
 
 // NewTracker creates a new MapleStory tracker
-func NewTracker(apiKey string) *Tracker {
+func NewTracker(apiKey string, opts Options) *Tracker {
 	return &Tracker{
 		client: nexon.NewClient(apiKey),
+		opts:   opts,
 	}
 }
 
@@ -35,17 +49,17 @@ func (t *Tracker) Type() game.GameType {
 
 // Description returns a brief description of the game
 func (t *Tracker) Description() string {
-	return "메이플스토리 캐릭터 레벨/경험치 추적"
+	return "메이플스토리 캐릭터 레벨/경험치/장비 추적"
 }
 
 // ValidatePlayerID validates the character name format
 func (t *Tracker) ValidatePlayerID(input string) error {
 	name := strings.TrimSpace(input)
 	if name == "" {
-		return fmt.Errorf("캐릭터 이름이 비어있습니다")
+		return i18n.Errorf("maple.empty_name")
 	}
 	if len(name) > 12 {
-		return fmt.Errorf("캐릭터 이름이 너무 깁니다 (최대 12자)")
+		return i18n.Errorf("maple.name_too_long")
 	}
 	return nil
 }
@@ -56,13 +70,13 @@ func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.Player
 
 	ocidResp, err := t.client.GetCharacterOCID(ctx, characterName)
 	if err != nil {
-		return nil, fmt.Errorf("캐릭터를 찾을 수 없습니다: %w", err)
+		return nil, i18n.Wrap(err, "maple.character_not_found")
 	}
 
 	// Get basic info to confirm the character exists and get the exact name
 	basicInfo, err := t.client.GetCharacterBasic(ctx, ocidResp.OCID)
 	if err != nil {
-		return nil, fmt.Errorf("캐릭터 정보를 가져올 수 없습니다: %w", err)
+		return nil, i18n.Wrap(err, "maple.character_unavailable")
 	}
 
 	return &game.PlayerInfo{
@@ -72,49 +86,312 @@ func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.Player
 	}, nil
 }
 
-// GetCurrentState returns a state hash based on level and exp for change detection
-func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (string, error) {
+// GetCurrentState returns a snapshot of the character's level, EXP,
+// combat power and equipment for change detection
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
 	basicInfo, err := t.client.GetCharacterBasic(ctx, playerID)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	// Stat and equipment are best-effort; a failure only hides those facets
+	stat, err := t.client.GetCharacterStat(ctx, playerID)
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to get character stat", "ocid", playerID, "error", err)
+		stat = nil
+	}
+	equipment, err := t.client.GetCharacterItemEquipment(ctx, playerID)
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to get character equipment", "ocid", playerID, "error", err)
+		equipment = nil
 	}
 
-	// Return state hash: lv:{level}:exp:{exp}
-	// This changes when level or exp changes
-	return fmt.Sprintf("lv:%d:exp:%d", basicInfo.CharacterLevel, basicInfo.CharacterExp), nil
+	return game.NewState(stateVersion, newCharacterState(basicInfo, stat, equipment))
+}
+
+// CompareStates detects level-ups, EXP gains, equipment and combat power changes
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	before, err := decodeState(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeState(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []game.Event
+
+	if after.Level > before.Level {
+		events = append(events, game.Event{
+			Type:    game.EventLevelUp,
+			Summary: fmt.Sprintf("Lv.%d → Lv.%d", before.Level, after.Level),
+		})
+	} else if after.Level == before.Level && after.Exp > before.Exp {
+		events = append(events, game.Event{
+			Type:    game.EventExpGained,
+			Summary: i18n.Default.T("maple.exp_summary", after.Exp-before.Exp),
+		})
+	}
+
+	// Summaries are in the default locale; notifications render them again
+	// in the locale of each target
+	if ratio, ok := combatPowerChange(before, after); ok {
+		events = append(events, game.Event{
+			Type:    game.EventCombatPowerChanged,
+			Summary: combatPowerSummary(i18n.Default, before, after, ratio),
+		})
+	}
+
+	for _, line := range diffEquipment(i18n.Default, before, after) {
+		events = append(events, game.Event{
+			Type:    game.EventEquipmentChanged,
+			Summary: line,
+		})
+	}
+
+	if t.opts.LevelUpOnly {
+		events = slices.DeleteFunc(events, func(e game.Event) bool { return e.Type != game.EventLevelUp })
+	}
+	return events, nil
+}
+
+// StateLevel returns the level and EXP recorded in a state
+func (t *Tracker) StateLevel(state *game.State) (int64, int64, error) {
+	s, err := decodeState(state)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.Level, s.Exp, nil
 }
 
 // CreateNotification fetches fresh character data and creates a Discord embed
-func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName, stateID string) (*discordgo.MessageEmbed, error) {
+// describing the events since the previous state, or the current status if none
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+
 	// Fetch fresh character data using the OCID (playerID)
 	basicInfo, err := t.client.GetCharacterBasic(ctx, playerID)
 	if err != nil {
-		return nil, fmt.Errorf("캐릭터 정보를 가져올 수 없습니다: %w", err)
+		return nil, i18n.Wrap(err, "maple.character_unavailable")
+	}
+
+	levelUp := change.HasEvent(game.EventLevelUp)
+	gearChanged := change.HasEvent(game.EventEquipmentChanged) || change.HasEvent(game.EventCombatPowerChanged)
+
+	title := l.T("maple.title_status")
+	color := 0xFF9900 // Orange color for MapleStory
+	switch {
+	case levelUp:
+		title = l.T("maple.title_level_up")
+		color = 0xF1C40F // Gold for level-ups
+	case gearChanged:
+		title = l.T("maple.title_gear")
+		color = 0x9B59B6 // Purple for gear
+	case change.HasEvent(game.EventExpGained):
+		title = l.T("maple.title_exp")
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title: "📊 메이플스토리 캐릭터 상태",
-		Color: 0xFF9900, // Orange color for MapleStory
+		Title: title,
+		Color: color,
 		Author: &discordgo.MessageEmbedAuthor{
 			Name: playerName,
 		},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "레벨",
+				Name:   l.T("maple.level"),
 				Value:  fmt.Sprintf("%d", basicInfo.CharacterLevel),
 				Inline: true,
 			},
 			{
-				Name:   "경험치",
+				Name:   l.T("maple.exp"),
 				Value:  fmt.Sprintf("%s%%", basicInfo.CharacterExpRate),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "메이플스토리",
+			Text: l.T("game.maplestory"),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
 
+	prev, cur, decoded := decodeChange(change)
+	var gearChanges []string
+	for _, event := range change.Events {
+		switch event.Type {
+		case game.EventLevelUp:
+			embed.Fields[0].Value = event.Summary
+			if decoded && cur.Level > prev.Level {
+				embed.Fields[0].Value += " " + l.T("maple.levels_gained", cur.Level-prev.Level)
+				t.addExpFields(l, embed, basicInfo, prev, cur, change)
+			}
+		case game.EventCombatPowerChanged:
+			value := event.Summary
+			if ratio, ok := combatPowerChange(prev, cur); decoded && ok {
+				value = combatPowerSummary(l, prev, cur, ratio)
+			}
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:   l.T("maple.combat_power"),
+				Value:  value,
+				Inline: false,
+			})
+		case game.EventEquipmentChanged:
+			gearChanges = append(gearChanges, event.Summary)
+		case game.EventExpGained:
+			if decoded {
+				t.addExpFields(l, embed, basicInfo, prev, cur, change)
+			}
+		}
+	}
+	if decoded && len(gearChanges) > 0 {
+		gearChanges = diffEquipment(l, prev, cur)
+	}
+
+	if len(gearChanges) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("maple.gear_changes"),
+			Value:  truncate(strings.Join(gearChanges, "\n"), 1024),
+			Inline: false,
+		})
+	}
+
 	return embed, nil
 }
+
+// combatPowerSummary describes a combat power change, e.g. "1억 → 1억 2000만 (+20.0%)"
+func combatPowerSummary(l i18n.Locale, before, after characterState, ratio float64) string {
+	return fmt.Sprintf("%s → %s (%+.1f%%)", formatCombatPower(l, before.CombatPower), formatCombatPower(l, after.CombatPower), ratio*100)
+}
+
+// decodeChange decodes the previous and current states of a change
+func decodeChange(change game.StateChange) (characterState, characterState, bool) {
+	if change.Previous == nil || change.Current == nil {
+		return characterState{}, characterState{}, false
+	}
+	prev, err := decodeState(change.Previous)
+	if err != nil {
+		return characterState{}, characterState{}, false
+	}
+	cur, err := decodeState(change.Current)
+	if err != nil {
+		return characterState{}, characterState{}, false
+	}
+	return prev, cur, true
+}
+
+// addExpFields adds the EXP% gained and the estimated time to the next level
+func (t *Tracker) addExpFields(l i18n.Locale, embed *discordgo.MessageEmbed, basicInfo *nexon.CharacterBasic, prev, cur characterState, change game.StateChange) {
+	curRate, err := strconv.ParseFloat(basicInfo.CharacterExpRate, 64)
+	if err != nil {
+		return
+	}
+	gained, ok := expGainedPercent(prev, cur, curRate, requiredExp(basicInfo))
+	if !ok {
+		return
+	}
+
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:   l.T("maple.exp_gained"),
+		Value:  fmt.Sprintf("+%.3f%%", gained),
+		Inline: true,
+	})
+
+	if change.PreviousAt.IsZero() {
+		return
+	}
+
+	elapsed := time.Since(change.PreviousAt)
+	if eta, ok := estimateLevelUp(100-curRate, gained, elapsed); ok {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("maple.next_level"),
+			Value:  l.T("maple.eta", formatDuration(l, eta)),
+			Inline: false,
+		})
+	}
+}
+
+// expGainedPercent returns the EXP gained between two states in percent of a
+// level, counting the rest of the old level and every full level in between
+// on a level-up. required is the EXP needed for the current level, used when
+// a state predates the stored EXP rate
+func expGainedPercent(prev, cur characterState, curRate float64, required int64) (float64, bool) {
+	// Older states only hold absolute EXP
+	rateUnknown := prev.ExpRate == 0 && prev.Exp > 0
+
+	var gained float64
+	switch {
+	case cur.Level == prev.Level:
+		switch {
+		case required > 0:
+			gained = float64(cur.Exp-prev.Exp) / float64(required) * 100
+		case !rateUnknown:
+			gained = curRate - prev.ExpRate
+		}
+	case cur.Level > prev.Level:
+		// The rest of the old level is measured against that level's
+		// requirement, which only the previous state's own rate reflects
+		if rateUnknown {
+			return 0, false
+		}
+		gained = (100 - prev.ExpRate) + float64(cur.Level-prev.Level-1)*100 + curRate
+	}
+	if gained <= 0 {
+		return 0, false
+	}
+	return gained, true
+}
+
+// requiredExp derives the total EXP needed for the current level from the
+// absolute EXP and the reported EXP percentage
+func requiredExp(info *nexon.CharacterBasic) int64 {
+	rate, err := strconv.ParseFloat(info.CharacterExpRate, 64)
+	if err != nil || rate <= 0 {
+		return 0
+	}
+	return int64(float64(info.CharacterExp) / (rate / 100))
+}
+
+// estimateLevelUp extrapolates the time needed to gain the remaining EXP%
+// from the EXP% gained over the elapsed interval
+func estimateLevelUp(remaining, gained float64, elapsed time.Duration) (time.Duration, bool) {
+	if remaining <= 0 || gained <= 0 || elapsed <= 0 {
+		return 0, false
+	}
+	eta := time.Duration(float64(elapsed) * remaining / gained)
+	if eta <= 0 {
+		return 0, false
+	}
+	return eta, true
+}
+
+// formatDuration formats a duration as days/hours/minutes
+func formatDuration(l i18n.Locale, d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	switch {
+	case days > 0:
+		return l.T("duration.days_hours", days, hours)
+	case hours > 0:
+		return l.T("duration.hours_minutes", hours, minutes)
+	default:
+		return l.T("duration.minutes", max(minutes, 1))
+	}
+}
+
+// ProfileURL returns the character's maple.gg page
+func (t *Tracker) ProfileURL(playerID, playerName string) string {
+	return fmt.Sprintf("https://maple.gg/u/%s", url.PathEscape(playerName))
+}
+
+// PlayerProfile summarizes the character's level, class and world
+func (t *Tracker) PlayerProfile(ctx context.Context, playerID, playerName string) (string, error) {
+	basicInfo, err := t.client.GetCharacterBasic(ctx, playerID)
+	if err != nil {
+		return "", i18n.Wrap(err, "maple.character_unavailable")
+	}
+	l := i18n.FromContext(ctx)
+	return l.T("maple.profile", basicInfo.CharacterLevel, basicInfo.CharacterExpRate, basicInfo.CharacterClass, basicInfo.WorldName), nil
+}
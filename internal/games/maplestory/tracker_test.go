@@ -0,0 +1,143 @@
+package maplestory
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+func TestExpGainedPercent(t *testing.T) {
+	tests := []struct {
+		name      string
+		prev, cur characterState
+		curRate   float64
+		required  int64
+		want      float64
+		wantOK    bool
+	}{
+		{
+			name:     "same level",
+			prev:     characterState{Level: 250, Exp: 1000, ExpRate: 10},
+			cur:      characterState{Level: 250, Exp: 3500, ExpRate: 35},
+			curRate:  35,
+			required: 10000,
+			want:     25,
+			wantOK:   true,
+		},
+		{
+			name:    "same level without requirement",
+			prev:    characterState{Level: 250, Exp: 1000, ExpRate: 10},
+			cur:     characterState{Level: 250, Exp: 3500, ExpRate: 35},
+			curRate: 35,
+			want:    25,
+			wantOK:  true,
+		},
+		{
+			name:     "legacy state at the same level",
+			prev:     characterState{Level: 250, Exp: 1000, legacy: true},
+			cur:      characterState{Level: 250, Exp: 3500, ExpRate: 35},
+			curRate:  35,
+			required: 10000,
+			want:     25,
+			wantOK:   true,
+		},
+		{
+			// The old level needed 10,000 and the new one 40,000: 75% of the
+			// old level is left however large the new requirement is
+			name:     "level-up",
+			prev:     characterState{Level: 250, Exp: 2500, ExpRate: 25},
+			cur:      characterState{Level: 251, Exp: 4000, ExpRate: 10},
+			curRate:  10,
+			required: 40000,
+			want:     85,
+			wantOK:   true,
+		},
+		{
+			name:     "levels skipped",
+			prev:     characterState{Level: 250, Exp: 2500, ExpRate: 25},
+			cur:      characterState{Level: 253, Exp: 4000, ExpRate: 10},
+			curRate:  10,
+			required: 40000,
+			want:     285,
+			wantOK:   true,
+		},
+		{
+			name:     "legacy state across a level-up",
+			prev:     characterState{Level: 250, Exp: 2500, legacy: true},
+			cur:      characterState{Level: 251, Exp: 4000, ExpRate: 10},
+			curRate:  10,
+			required: 40000,
+		},
+		{
+			name:     "no gain",
+			prev:     characterState{Level: 250, Exp: 2500, ExpRate: 25},
+			cur:      characterState{Level: 250, Exp: 2500, ExpRate: 25},
+			curRate:  25,
+			required: 10000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := expGainedPercent(tt.prev, tt.cur, tt.curRate, tt.required)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expGainedPercent = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareStates(t *testing.T) {
+	gear := map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 17}}
+	upgraded := map[string]equipmentState{"모자": {Name: "앱솔랩스 모자", Starforce: 18}}
+
+	base := characterState{Level: 250, Exp: 1000, ExpRate: 10, CombatPower: 100_000_000, Equipment: gear}
+	expGained := characterState{Level: 250, Exp: 2000, ExpRate: 20, CombatPower: 100_000_000, Equipment: gear}
+	geared := characterState{Level: 250, Exp: 1000, ExpRate: 10, CombatPower: 120_000_000, Equipment: upgraded}
+	leveled := characterState{Level: 251, Exp: 500, ExpRate: 1, CombatPower: 120_000_000, Equipment: upgraded}
+
+	tests := []struct {
+		name        string
+		levelUpOnly bool
+		prev, cur   characterState
+		want        []game.EventType
+	}{
+		{"exp gained", false, base, expGained, []game.EventType{game.EventExpGained}},
+		{"gear changed", false, base, geared, []game.EventType{game.EventCombatPowerChanged, game.EventEquipmentChanged}},
+		{"level-up with gear", false, base, leveled, []game.EventType{game.EventLevelUp, game.EventCombatPowerChanged, game.EventEquipmentChanged}},
+		{"level-up only: exp gained", true, base, expGained, nil},
+		{"level-up only: gear changed", true, base, geared, nil},
+		{"level-up only: level-up with gear", true, base, leveled, []game.EventType{game.EventLevelUp}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewTracker("test-key", Options{LevelUpOnly: tt.levelUpOnly})
+			prev, err := game.NewState(stateVersion, tt.prev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cur, err := game.NewState(stateVersion, tt.cur)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			events, err := tracker.CompareStates(prev, cur)
+			if err != nil {
+				t.Fatalf("CompareStates: %v", err)
+			}
+			var got []game.EventType
+			for _, e := range events {
+				got = append(got, e.Type)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
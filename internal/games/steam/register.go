@@ -0,0 +1,17 @@
+package steam
+
+import (
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+func init() {
+	game.RegisterFactory(game.Factory{
+		Type:         game.GameTypeSteam,
+		Name:         "스팀",
+		RequiredKeys: []string{"STEAM_API_KEY"},
+		New: func(cfg *config.Config) (game.Tracker, error) {
+			return NewTracker(cfg.SteamAPIKey), nil
+		},
+	})
+}
@@ -0,0 +1,556 @@
+package steam
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/steam"
+)
+
+const (
+	// stateVersion is the current version of libraryState
+	// Version 1 stored playtime rounded down to two hours
+	stateVersion = 2
+
+	// playtimeThreshold is the playtime gain (in minutes) between two polls
+	// worth notifying; Steam mostly adds playtime when a session ends
+	playtimeThreshold = 120
+
+	// maxAchievementGames caps how many recently played games are checked for achievements per poll
+	maxAchievementGames = 10
+
+	// maxAchievementFields caps how many unlocked achievements are listed in one notification
+	maxAchievementFields = 5
+)
+
+var (
+	steamIDPattern = regexp.MustCompile(`^7656119\d{10}$`)
+	vanityPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]{2,32}$`)
+)
+
+// libraryState is the tracked state of a Steam player
+type libraryState struct {
+	Games      map[int]gameState `json:"games"`                 // keyed by app ID
+	LastUnlock int64             `json:"last_unlock,omitempty"` // latest achievement unlock time seen
+}
+
+// gameState is the tracked state of one owned game
+type gameState struct {
+	Name       string `json:"n"`
+	Playtime   int    `json:"pt"`           // minutes
+	LastUnlock int64  `json:"lu,omitempty"` // only for recently played games
+}
+
+// Tracker implements game.Tracker for Steam
+type Tracker struct {
+	client *steam.Client
+}
+
+// NewTracker creates a new Steam tracker
+func NewTracker(apiKey string) *Tracker {
+	return &Tracker{
+		client: steam.NewClient(apiKey),
+	}
+}
+
+// Name returns the human-readable name of the game
+func (t *Tracker) Name() string {
+	return "스팀"
+}
+
+// Type returns the game type identifier
+func (t *Tracker) Type() game.GameType {
+	return game.GameTypeSteam
+}
+
+// Description returns a brief description of the game
+func (t *Tracker) Description() string {
+	return "스팀 업적 달성, 플레이 시간, 새 게임 추적 (공개 프로필 필요)"
+}
+
+// parsePlayerInput extracts a SteamID64 or vanity name from an ID, vanity name or profile URL
+func parsePlayerInput(input string) (steamID, vanity string, err error) {
+	value := strings.TrimSpace(input)
+	value = strings.TrimPrefix(value, "https://")
+	value = strings.TrimPrefix(value, "http://")
+	value = strings.TrimPrefix(value, "steamcommunity.com")
+	value = strings.Trim(value, "/")
+
+	if rest, ok := strings.CutPrefix(value, "profiles/"); ok {
+		value = rest
+	} else if rest, ok := strings.CutPrefix(value, "id/"); ok {
+		if !vanityPattern.MatchString(rest) {
+			return "", "", i18n.Errorf("steam.invalid_profile_url")
+		}
+		return "", rest, nil
+	}
+
+	switch {
+	case steamIDPattern.MatchString(value):
+		return value, "", nil
+	case vanityPattern.MatchString(value):
+		return "", value, nil
+	default:
+		return "", "", i18n.Errorf("steam.invalid_player")
+	}
+}
+
+// ValidatePlayerID validates a SteamID64, vanity name or profile URL
+func (t *Tracker) ValidatePlayerID(input string) error {
+	_, _, err := parsePlayerInput(input)
+	return err
+}
+
+// ResolvePlayer looks up player information from the Steam Web API
+func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
+	steamID, vanity, err := parsePlayerInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if steamID == "" {
+		steamID, err = t.client.ResolveVanityURL(ctx, vanity)
+		if err != nil {
+			return nil, i18n.Wrap(err, "error.player_not_found")
+		}
+	}
+
+	summary, err := t.client.GetPlayerSummary(ctx, steamID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.player_not_found")
+	}
+
+	return &game.PlayerInfo{
+		ID:          summary.SteamID,
+		DisplayName: summary.PersonaName,
+		GameType:    game.GameTypeSteam,
+	}, nil
+}
+
+// GetCurrentState returns the player's library, playtime and latest
+// achievement unlock times of recently played games
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
+	games, err := t.client.GetOwnedGames(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Private profiles return no games; nothing to track
+	if len(games) == 0 {
+		return nil, nil
+	}
+
+	state := libraryState{Games: make(map[int]gameState, len(games))}
+	for _, g := range games {
+		state.Games[g.AppID] = gameState{
+			Name:     g.Name,
+			Playtime: g.PlaytimeForever,
+		}
+	}
+
+	for _, g := range recentlyPlayed(games, maxAchievementGames) {
+		achievements, err := t.client.GetPlayerAchievements(ctx, playerID, g.AppID)
+		if err != nil {
+			// Games without achievements return an error; that's expected
+			slog.DebugContext(ctx, "Failed to get achievements", "steamID", playerID, "appID", g.AppID, "error", err)
+			continue
+		}
+
+		gs := state.Games[g.AppID]
+		for _, a := range achievements {
+			if a.Achieved == 1 && a.UnlockTime > gs.LastUnlock {
+				gs.LastUnlock = a.UnlockTime
+			}
+		}
+		state.Games[g.AppID] = gs
+		state.LastUnlock = max(state.LastUnlock, gs.LastUnlock)
+	}
+
+	return game.NewState(stateVersion, state)
+}
+
+// decodeState decodes a stored state
+func decodeState(state *game.State) (libraryState, error) {
+	var s libraryState
+	if err := state.Decode(&s); err != nil {
+		return libraryState{}, err
+	}
+	return s, nil
+}
+
+// CompareStates detects new achievements, large playtime jumps and new games
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	before, err := decodeState(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeState(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []game.Event
+	for _, appID := range sortedAppIDs(after.Games) {
+		g := after.Games[appID]
+		old, owned := before.Games[appID]
+
+		if !owned {
+			events = append(events, game.Event{Type: game.EventNewGame, Summary: g.Name})
+			continue
+		}
+
+		if g.LastUnlock > before.LastUnlock {
+			events = append(events, game.Event{Type: game.EventAchievementUnlock, Summary: g.Name})
+		}
+
+		// Rounded version 1 playtimes can't be compared with exact ones
+		if prev.Version >= stateVersion && g.Playtime-old.Playtime >= playtimeThreshold {
+			events = append(events, game.Event{Type: game.EventPlaytimeJump, Summary: g.Name})
+		}
+	}
+
+	return events, nil
+}
+
+// playtimeLines describes the playtime gained in each game between two states
+func playtimeLines(l i18n.Locale, change game.StateChange) []string {
+	if change.Previous == nil {
+		return nil
+	}
+	before, err := decodeState(change.Previous)
+	if err != nil {
+		return nil
+	}
+	after, err := decodeState(change.Current)
+	if err != nil {
+		return nil
+	}
+
+	var lines []string
+	for _, appID := range sortedAppIDs(after.Games) {
+		g := after.Games[appID]
+		old, owned := before.Games[appID]
+		if owned && g.Playtime-old.Playtime >= playtimeThreshold {
+			lines = append(lines, l.T("steam.playtime_jump", g.Name, float64(g.Playtime-old.Playtime)/60, g.Playtime/60))
+		}
+	}
+	return lines
+}
+
+// CreateNotification creates a Discord embed for the detected events,
+// or a profile summary if there are none
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	embed := &discordgo.MessageEmbed{
+		Color: 0x1B2838, // Steam navy
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("game.steam"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	if summary, err := t.client.GetPlayerSummary(ctx, playerID); err == nil {
+		embed.Author.URL = summary.ProfileURL
+		embed.Author.IconURL = summary.Avatar
+	}
+
+	if len(change.Events) == 0 {
+		return t.fillProfile(ctx, embed, playerID)
+	}
+
+	var newGames []string
+	for _, event := range change.Events {
+		if event.Type == game.EventNewGame {
+			newGames = append(newGames, event.Summary)
+		}
+	}
+
+	var playtime []string
+	if change.HasEvent(game.EventPlaytimeJump) {
+		playtime = playtimeLines(l, change)
+	}
+
+	unlocked := 0
+	if change.HasEvent(game.EventAchievementUnlock) && change.Previous != nil {
+		unlocked = t.addAchievements(ctx, embed, playerID, change)
+	}
+
+	if len(newGames) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("steam.new_games"),
+			Value: truncate(strings.Join(newGames, "\n"), 1024),
+		})
+	}
+	if len(playtime) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("steam.playtime"),
+			Value: truncate(strings.Join(playtime, "\n"), 1024),
+		})
+	}
+
+	switch {
+	case unlocked > 0:
+		embed.Title = l.T("steam.title_achievements", unlocked)
+		embed.Color = 0xF1C40F // Gold for achievements
+	case len(newGames) > 0:
+		embed.Title = l.T("steam.title_new_games")
+	case len(playtime) > 0:
+		embed.Title = l.T("steam.title_playtime")
+	default:
+		// Only achievement events that turned out to be old unlocks
+		return nil, nil
+	}
+
+	return embed, nil
+}
+
+// PlayerStats returns the player's library size, total playtime and recently played games
+func (t *Tracker) PlayerStats(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error) {
+	return t.CreateNotification(ctx, playerID, playerName, game.StateChange{})
+}
+
+// LiveStatus returns an embed with the game the player is in, or nil if they
+// are not playing or hide their game details
+func (t *Tracker) LiveStatus(ctx context.Context, playerID, playerName string) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	summary, err := t.client.GetPlayerSummary(ctx, playerID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "steam.profile_unavailable")
+	}
+	if summary.GameExtraInfo == "" {
+		return nil, nil
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       l.T("lol.live_title"),
+		Description: l.T("steam.playing", summary.GameExtraInfo),
+		Color:       0x1B2838, // Steam navy
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    playerName,
+			URL:     summary.ProfileURL,
+			IconURL: summary.Avatar,
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("game.steam"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+	if appID, err := strconv.Atoi(summary.GameID); err == nil {
+		embed.URL = steam.StoreURL(appID)
+	}
+
+	return embed, nil
+}
+
+// addAchievements adds fields for achievements unlocked since the previous state
+// and returns how many were found
+func (t *Tracker) addAchievements(ctx context.Context, embed *discordgo.MessageEmbed, playerID string, change game.StateChange) int {
+	l := i18n.FromContext(ctx)
+	prev, errPrev := decodeState(change.Previous)
+	cur, errCur := decodeState(change.Current)
+	if errPrev != nil || errCur != nil {
+		return 0
+	}
+
+	type unlock struct {
+		game   string
+		name   string
+		desc   string
+		icon   string
+		rarity float64
+	}
+
+	// A game's latest unlock can move past the stored watermark just by
+	// re-entering the recently played window, so also require the unlock to
+	// be newer than the previous state itself
+	since := prev.LastUnlock
+	if !change.PreviousAt.IsZero() {
+		since = max(since, change.PreviousAt.Unix())
+	}
+
+	var unlocks []unlock
+	for _, appID := range sortedAppIDs(cur.Games) {
+		g := cur.Games[appID]
+		if g.LastUnlock <= prev.LastUnlock {
+			continue
+		}
+
+		achievements, err := t.client.GetPlayerAchievements(ctx, playerID, appID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to get achievements", "steamID", playerID, "appID", appID, "error", err)
+			continue
+		}
+		schema, err := t.client.GetAchievementSchema(ctx, appID)
+		if err != nil {
+			slog.DebugContext(ctx, "Failed to get achievement schema", "appID", appID, "error", err)
+		}
+		percentages, err := t.client.GetGlobalAchievementPercentages(ctx, appID)
+		if err != nil {
+			slog.DebugContext(ctx, "Failed to get achievement percentages", "appID", appID, "error", err)
+		}
+
+		for _, a := range achievements {
+			if a.Achieved != 1 || a.UnlockTime <= since {
+				continue
+			}
+			u := unlock{game: g.Name, name: a.Name, desc: a.Description, rarity: -1}
+			if s, ok := schema[a.APIName]; ok {
+				u.name = s.DisplayName
+				u.icon = s.Icon
+				if u.desc == "" {
+					u.desc = s.Description
+				}
+			}
+			if p, ok := percentages[a.APIName]; ok {
+				u.rarity = p
+			}
+			if u.name == "" {
+				u.name = a.APIName
+			}
+			unlocks = append(unlocks, u)
+		}
+	}
+
+	if len(unlocks) == 0 {
+		return 0
+	}
+
+	// Rarest first; unknown rarity last
+	sort.SliceStable(unlocks, func(i, j int) bool {
+		ri, rj := unlocks[i].rarity, unlocks[j].rarity
+		if ri < 0 || rj < 0 {
+			return rj < 0 && ri >= 0
+		}
+		return ri < rj
+	})
+
+	if unlocks[0].icon != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: unlocks[0].icon}
+	}
+
+	for idx, u := range unlocks {
+		if idx == maxAchievementFields {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  "…",
+				Value: l.T("steam.more_achievements", len(unlocks)-maxAchievementFields),
+			})
+			break
+		}
+
+		value := u.desc
+		if u.rarity >= 0 {
+			value = strings.TrimSpace(value + "\n" + l.T("steam.rarity", formatRarity(u.rarity)))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("🏆 %s — %s", u.name, u.game),
+			Value: valueOrDash(truncate(value, 1024)),
+		})
+	}
+
+	return len(unlocks)
+}
+
+// fillProfile describes the player's library for status lookups
+func (t *Tracker) fillProfile(ctx context.Context, embed *discordgo.MessageEmbed, playerID string) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	games, err := t.client.GetOwnedGames(ctx, playerID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "steam.games_unavailable")
+	}
+
+	embed.Title = l.T("steam.title_profile")
+
+	total := 0
+	for _, g := range games {
+		total += g.PlaytimeForever
+	}
+	embed.Fields = append(embed.Fields,
+		&discordgo.MessageEmbedField{Name: l.T("steam.owned_games"), Value: l.T("steam.game_count", len(games)), Inline: true},
+		&discordgo.MessageEmbedField{Name: l.T("steam.total_playtime"), Value: l.T("steam.hours", total/60), Inline: true},
+	)
+
+	var sb strings.Builder
+	for _, g := range recentlyPlayed(games, 5) {
+		sb.WriteString(l.T("steam.recent_line", g.Name, steam.StoreURL(g.AppID), float64(g.Playtime2Weeks)/60, g.PlaytimeForever/60) + "\n")
+	}
+	if sb.Len() > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  l.T("steam.recently_played"),
+			Value: truncate(sb.String(), 1024),
+		})
+	}
+
+	return embed, nil
+}
+
+// recentlyPlayed returns up to n games played in the last two weeks, most played first
+func recentlyPlayed(games []steam.OwnedGame, n int) []steam.OwnedGame {
+	var recent []steam.OwnedGame
+	for _, g := range games {
+		if g.Playtime2Weeks > 0 {
+			recent = append(recent, g)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].Playtime2Weeks > recent[j].Playtime2Weeks
+	})
+	if len(recent) > n {
+		recent = recent[:n]
+	}
+	return recent
+}
+
+// sortedAppIDs returns the app IDs of a library in ascending order
+func sortedAppIDs(games map[int]gameState) []int {
+	ids := make([]int, 0, len(games))
+	for id := range games {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// formatRarity formats a global unlock percentage with a rarity marker
+func formatRarity(p float64) string {
+	switch {
+	case p < 5:
+		return fmt.Sprintf("%.1f%% 💎", p)
+	case p < 20:
+		return fmt.Sprintf("%.1f%% ✨", p)
+	default:
+		return fmt.Sprintf("%.1f%%", p)
+	}
+}
+
+// truncate shortens a string to fit within a Discord embed length limit
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// ProfileURL returns the player's Steam Community page
+func (t *Tracker) ProfileURL(playerID, playerName string) string {
+	return fmt.Sprintf("https://steamcommunity.com/profiles/%s", playerID)
+}
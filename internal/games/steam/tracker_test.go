@@ -0,0 +1,185 @@
+package steam
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/steam"
+)
+
+func TestParsePlayerInput(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantID     string
+		wantVanity string
+		wantErr    bool
+	}{
+		{input: "76561197960287930", wantID: "76561197960287930"},
+		{input: "https://steamcommunity.com/profiles/76561197960287930/", wantID: "76561197960287930"},
+		{input: "steamcommunity.com/id/gabelogannewell", wantVanity: "gabelogannewell"},
+		{input: "  gabe_newell  ", wantVanity: "gabe_newell"},
+		{input: "https://steamcommunity.com/id/bad name", wantErr: true},
+		{input: "a", wantErr: true},
+		{input: "not a player!", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			id, vanity, err := parsePlayerInput(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if id != tt.wantID || vanity != tt.wantVanity {
+				t.Errorf("parsePlayerInput = (%q, %q), want (%q, %q)", id, vanity, tt.wantID, tt.wantVanity)
+			}
+		})
+	}
+}
+
+func TestCompareStates(t *testing.T) {
+	library := func(lastUnlock int64, games map[int]gameState) libraryState {
+		return libraryState{Games: games, LastUnlock: lastUnlock}
+	}
+	base := library(100, map[int]gameState{
+		10: {Name: "Counter-Strike", Playtime: 600},
+		20: {Name: "Dota 2", Playtime: 1200, LastUnlock: 100},
+	})
+
+	tests := []struct {
+		name        string
+		prevVersion int
+		prev, cur   libraryState
+		want        []game.Event
+	}{
+		{
+			name:        "no change",
+			prevVersion: stateVersion,
+			prev:        base,
+			cur:         base,
+		},
+		{
+			name:        "new game",
+			prevVersion: stateVersion,
+			prev:        base,
+			cur: library(100, map[int]gameState{
+				10: {Name: "Counter-Strike", Playtime: 600},
+				20: {Name: "Dota 2", Playtime: 1200, LastUnlock: 100},
+				30: {Name: "Portal 2"},
+			}),
+			want: []game.Event{{Type: game.EventNewGame, Summary: "Portal 2"}},
+		},
+		{
+			name:        "achievement and playtime",
+			prevVersion: stateVersion,
+			prev:        base,
+			cur: library(200, map[int]gameState{
+				10: {Name: "Counter-Strike", Playtime: 600},
+				20: {Name: "Dota 2", Playtime: 1200 + playtimeThreshold, LastUnlock: 200},
+			}),
+			want: []game.Event{
+				{Type: game.EventAchievementUnlock, Summary: "Dota 2"},
+				{Type: game.EventPlaytimeJump, Summary: "Dota 2"},
+			},
+		},
+		{
+			name:        "playtime below the threshold",
+			prevVersion: stateVersion,
+			prev:        base,
+			cur: library(100, map[int]gameState{
+				10: {Name: "Counter-Strike", Playtime: 600 + playtimeThreshold - 1},
+				20: {Name: "Dota 2", Playtime: 1200, LastUnlock: 100},
+			}),
+		},
+		{
+			// Version 1 rounded playtime down to two hours
+			name:        "rounded previous playtime",
+			prevVersion: 1,
+			prev:        base,
+			cur: library(100, map[int]gameState{
+				10: {Name: "Counter-Strike", Playtime: 600 + playtimeThreshold},
+				20: {Name: "Dota 2", Playtime: 1200, LastUnlock: 100},
+			}),
+		},
+	}
+
+	tracker := NewTracker("test-key")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, err := game.NewState(tt.prevVersion, tt.prev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cur, err := game.NewState(stateVersion, tt.cur)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tracker.CompareStates(prev, cur)
+			if err != nil {
+				t.Fatalf("CompareStates: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("events = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaytimeLines(t *testing.T) {
+	prev, _ := game.NewState(stateVersion, libraryState{Games: map[int]gameState{
+		10: {Name: "Counter-Strike", Playtime: 600},
+		20: {Name: "Dota 2", Playtime: 1200},
+	}})
+	cur, _ := game.NewState(stateVersion, libraryState{Games: map[int]gameState{
+		10: {Name: "Counter-Strike", Playtime: 610},
+		20: {Name: "Dota 2", Playtime: 1350},
+		30: {Name: "Portal 2", Playtime: 300},
+	}})
+
+	l := i18n.Korean
+	got := playtimeLines(l, game.StateChange{Previous: prev, Current: cur})
+	want := []string{l.T("steam.playtime_jump", "Dota 2", 2.5, 22)}
+	if !slices.Equal(got, want) {
+		t.Errorf("playtimeLines = %q, want %q", got, want)
+	}
+
+	if got := playtimeLines(l, game.StateChange{Current: cur}); got != nil {
+		t.Errorf("playtimeLines without a previous state = %q, want none", got)
+	}
+}
+
+func TestRecentlyPlayed(t *testing.T) {
+	games := []steam.OwnedGame{
+		{AppID: 1, Playtime2Weeks: 30},
+		{AppID: 2},
+		{AppID: 3, Playtime2Weeks: 120},
+		{AppID: 4, Playtime2Weeks: 60},
+	}
+
+	var got []int
+	for _, g := range recentlyPlayed(games, 2) {
+		got = append(got, g.AppID)
+	}
+	if want := []int{3, 4}; !slices.Equal(got, want) {
+		t.Errorf("recentlyPlayed = %v, want %v", got, want)
+	}
+}
+
+func TestFormatRarity(t *testing.T) {
+	tests := []struct {
+		p    float64
+		want string
+	}{
+		{1.23, "1.2% 💎"},
+		{12.5, "12.5% ✨"},
+		{50, "50.0%"},
+	}
+
+	for _, tt := range tests {
+		if got := formatRarity(tt.p); got != tt.want {
+			t.Errorf("formatRarity(%v) = %q, want %q", tt.p, got, tt.want)
+		}
+	}
+}
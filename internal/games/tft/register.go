@@ -0,0 +1,18 @@
+package tft
+
+import (
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+func init() {
+	game.RegisterFactory(game.Factory{
+		Type:         game.GameTypeTFT,
+		Name:         "전략적 팀 전투",
+		RequiredKeys: []string{"RIOT_API_KEY"},
+		New: func(cfg *config.Config) (game.Tracker, error) {
+			return NewTrackerWithClient(riot.SharedClient(cfg.RiotAPIKey)), nil
+		},
+	})
+}
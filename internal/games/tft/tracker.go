@@ -0,0 +1,374 @@
+package tft
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+// rankedQueue is the queue type of ranked TFT league entries
+const rankedQueue = "RANKED_TFT"
+
+// stateVersion is the current version of playerState
+const stateVersion = 1
+
+// playerState is the tracked state of a TFT player
+type playerState struct {
+	MatchID string     `json:"match_id"`
+	Rank    *rankState `json:"rank,omitempty"`
+}
+
+// rankState is a snapshot of a player's ranked TFT standing
+type rankState struct {
+	Tier string `json:"tier"`
+	Rank string `json:"rank"`
+	LP   int    `json:"lp"`
+}
+
+// String formats the rank as e.g. "GOLD II 45LP"
+func (r *rankState) String() string {
+	return fmt.Sprintf("%s %s %dLP", r.Tier, r.Rank, r.LP)
+}
+
+// Tracker implements game.Tracker for Teamfight Tactics
+type Tracker struct {
+	client *riot.Client
+}
+
+// NewTracker creates a new TFT tracker
+func NewTracker(apiKey string) *Tracker {
+	return NewTrackerWithClient(riot.NewClient(apiKey))
+}
+
+// NewTrackerWithClient creates a TFT tracker using the given Riot client
+func NewTrackerWithClient(client *riot.Client) *Tracker {
+	return &Tracker{
+		client: client,
+	}
+}
+
+// Name returns the human-readable name of the game
+func (t *Tracker) Name() string {
+	return "전략적 팀 전투"
+}
+
+// Type returns the game type identifier
+func (t *Tracker) Type() game.GameType {
+	return game.GameTypeTFT
+}
+
+// Description returns a brief description of the game
+func (t *Tracker) Description() string {
+	return "전략적 팀 전투(TFT) 경기 결과 및 랭크 LP 추적"
+}
+
+// ValidatePlayerID validates the Riot ID format
+func (t *Tracker) ValidatePlayerID(input string) error {
+	parts := strings.Split(input, "#")
+	if len(parts) != 2 {
+		return i18n.Errorf("lol.invalid_format")
+	}
+
+	if strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return i18n.Errorf("lol.empty_name")
+	}
+
+	return nil
+}
+
+// ResolvePlayer looks up player information from the Riot Account API
+func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
+	parts := strings.Split(input, "#")
+	if len(parts) != 2 {
+		return nil, i18n.Errorf("lol.invalid_riot_id")
+	}
+
+	account, err := t.client.GetAccountByRiotID(ctx, strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.player_not_found")
+	}
+
+	return &game.PlayerInfo{
+		ID:          account.PUUID,
+		DisplayName: fmt.Sprintf("%s#%s", account.GameName, account.TagLine),
+		GameType:    game.GameTypeTFT,
+	}, nil
+}
+
+// GetCurrentState returns the latest TFT match ID and ranked standing
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
+	matchIDs, err := t.client.GetTFTMatchIDsByPUUID(ctx, playerID, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matchIDs) == 0 {
+		return nil, nil
+	}
+
+	state := playerState{MatchID: matchIDs[0]}
+
+	// Rank is best-effort; unranked players simply have no entry
+	entries, err := t.client.GetTFTLeagueEntries(ctx, playerID)
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to get TFT league entries", "puuid", playerID, "error", err)
+	}
+	for _, entry := range entries {
+		if entry.QueueType == rankedQueue {
+			state.Rank = &rankState{Tier: entry.Tier, Rank: entry.Rank, LP: entry.LeaguePoints}
+		}
+	}
+
+	return game.NewState(stateVersion, state)
+}
+
+// decodeState decodes a stored state, upgrading legacy match ID strings
+func decodeState(state *game.State) (playerState, error) {
+	if matchID, ok := state.Legacy(); ok {
+		return playerState{MatchID: matchID}, nil
+	}
+
+	var s playerState
+	if err := state.Decode(&s); err != nil {
+		return playerState{}, err
+	}
+	return s, nil
+}
+
+// CompareStates reports completed matches and tier/division changes, with or
+// without a new match
+// LP changes alone are shown on the match notification rather than as events
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	before, err := decodeState(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeState(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []game.Event
+	if after.MatchID != "" && after.MatchID != before.MatchID {
+		events = append(events, game.Event{Type: game.EventMatchCompleted, Summary: after.MatchID})
+	}
+
+	// Rank is compared on its own: the league API often updates a poll after the match appears
+	if before.Rank != nil && after.Rank != nil &&
+		(before.Rank.Tier != after.Rank.Tier || before.Rank.Rank != after.Rank.Rank) {
+		events = append(events, game.Event{
+			Type:    game.EventRankChanged,
+			Summary: fmt.Sprintf("%s %s → %s %s", before.Rank.Tier, before.Rank.Rank, after.Rank.Tier, after.Rank.Rank),
+		})
+	}
+
+	return events, nil
+}
+
+// StateStanding returns the ranked TFT standing recorded in a state
+func (t *Tracker) StateStanding(state *game.State) (*game.Standing, error) {
+	s, err := decodeState(state)
+	if err != nil || s.Rank == nil {
+		return nil, err
+	}
+	return &game.Standing{Tier: s.Rank.Tier, Division: s.Rank.Rank, LP: s.Rank.LP}, nil
+}
+
+// CreateNotification fetches match details and creates a Discord embed
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	state, err := decodeState(change.Current)
+	if err != nil {
+		return nil, err
+	}
+
+	// A rank update arriving after its match gets a notification of its own
+	if !change.HasEvent(game.EventMatchCompleted) {
+		return t.rankEmbed(l, playerName, state, change), nil
+	}
+
+	match, err := t.client.GetTFTMatch(ctx, state.MatchID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.match_unavailable")
+	}
+
+	participant := match.FindParticipant(playerID)
+	if participant == nil {
+		return &discordgo.MessageEmbed{
+			Title:       l.T("tft.match_result"),
+			Description: l.T("lol.player_not_in_match"),
+			Color:       0xFF0000,
+		}, nil
+	}
+
+	embed := createMatchEmbed(l, playerName, match, participant)
+
+	if state.Rank != nil {
+		value := state.Rank.String()
+		if change.Previous != nil {
+			if prev, err := decodeState(change.Previous); err == nil && prev.Rank != nil &&
+				prev.Rank.Tier == state.Rank.Tier && prev.Rank.Rank == state.Rank.Rank {
+				value += fmt.Sprintf(" (%+d)", state.Rank.LP-prev.Rank.LP)
+			}
+		}
+		for _, event := range change.Events {
+			if event.Type == game.EventRankChanged {
+				value += fmt.Sprintf("\n%s", event.Summary)
+			}
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("tft.ranked"),
+			Value:  value,
+			Inline: false,
+		})
+	}
+
+	return embed, nil
+}
+
+// rankEmbed creates the notification of a tier or division change without a new match
+func (t *Tracker) rankEmbed(l i18n.Locale, playerName string, state playerState, change game.StateChange) *discordgo.MessageEmbed {
+	color := 0xE74C3C // Red for demotions
+	if game.Promoted(t, change) {
+		color = 0x2ECC71 // Green for promotions
+	}
+
+	var summaries []string
+	for _, event := range change.Events {
+		if event.Type == game.EventRankChanged {
+			summaries = append(summaries, event.Summary)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: l.T("tft.rank_changed"),
+		Color: color,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+		Description: strings.Join(summaries, "\n"),
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	if state.Rank != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("tft.ranked"),
+			Value:  state.Rank.String(),
+			Inline: false,
+		})
+	}
+	return embed
+}
+
+// createMatchEmbed creates a Discord embed for a TFT match notification
+func createMatchEmbed(l i18n.Locale, playerName string, match *riot.TFTMatch, p *riot.TFTParticipant) *discordgo.MessageEmbed {
+	color := 0xE74C3C // Red for bottom four
+	switch {
+	case p.Placement == 1:
+		color = 0xF1C40F // Gold for first place
+	case p.Placement <= 4:
+		color = 0x2ECC71 // Green for top four
+	}
+
+	minutes := int(match.Info.GameLength) / 60
+	seconds := int(match.Info.GameLength) % 60
+
+	embed := &discordgo.MessageEmbed{
+		Title: l.T("tft.placement", p.Placement),
+		Color: color,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+		Description: fmt.Sprintf("**TFT** | %s", riot.GetTFTQueueName(match.Info.QueueID)),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   l.T("tft.level"),
+				Value:  fmt.Sprintf("%d", p.Level),
+				Inline: true,
+			},
+			{
+				Name:   l.T("tft.damage"),
+				Value:  fmt.Sprintf("%d", p.TotalDamageToPlayers),
+				Inline: true,
+			},
+			{
+				Name:   l.T("lol.duration"),
+				Value:  fmt.Sprintf("%d:%02d", minutes, seconds),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("lol.match_id", match.Metadata.MatchID),
+		},
+		Timestamp: time.UnixMilli(match.Info.GameDatetime).Format(time.RFC3339),
+	}
+
+	if traits := formatTraits(p.ActiveTraits()); traits != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: l.T("tft.traits"), Value: traits})
+	}
+	if units := formatUnits(p.Units); units != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: l.T("tft.units"), Value: units})
+	}
+	if len(p.Augments) > 0 {
+		names := make([]string, len(p.Augments))
+		for i, a := range p.Augments {
+			names[i] = riot.TFTDisplayName(a)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: l.T("tft.augments"), Value: strings.Join(names, ", ")})
+	}
+
+	return embed
+}
+
+// formatTraits formats active traits as "Sorcerer 6 · Bruiser 4"
+func formatTraits(traits []riot.TFTTrait) string {
+	parts := make([]string, len(traits))
+	for i, t := range traits {
+		parts[i] = fmt.Sprintf("%s %d", riot.TFTDisplayName(t.Name), t.NumUnits)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// formatUnits formats units with their star level, highest cost first
+func formatUnits(units []riot.TFTUnit) string {
+	sorted := make([]riot.TFTUnit, len(units))
+	copy(sorted, units)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rarity > sorted[j].Rarity
+	})
+
+	parts := make([]string, len(sorted))
+	for i, u := range sorted {
+		parts[i] = fmt.Sprintf("%s %s", riot.TFTDisplayName(u.CharacterID), strings.Repeat("★", u.Tier))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ProfileURL returns the player's OP.GG TFT page
+func (t *Tracker) ProfileURL(playerID, playerName string) string {
+	return fmt.Sprintf("https://op.gg/tft/summoners/kr/%s", url.PathEscape(strings.Replace(playerName, "#", "-", 1)))
+}
+
+// PlayerProfile summarizes the player's ranked TFT standing and season record
+func (t *Tracker) PlayerProfile(ctx context.Context, playerID, playerName string) (string, error) {
+	l := i18n.FromContext(ctx)
+	entries, err := t.client.GetTFTLeagueEntries(ctx, playerID)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.QueueType == rankedQueue {
+			standing := game.Standing{Tier: entry.Tier, Division: entry.Rank, LP: entry.LeaguePoints}
+			return l.T("profile.ranked", l.T("tft.ranked"), standing, entry.Wins, entry.Losses, entry.WinRate()), nil
+		}
+	}
+	return l.T("profile.unranked", l.T("tft.ranked")), nil
+}
@@ -0,0 +1,137 @@
+package tft
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+func TestCompareStates(t *testing.T) {
+	gold := &rankState{Tier: "GOLD", Rank: "I", LP: 80}
+	goldMoreLP := &rankState{Tier: "GOLD", Rank: "I", LP: 99}
+	platinum := &rankState{Tier: "PLATINUM", Rank: "IV", LP: 0}
+
+	tests := []struct {
+		name        string
+		prev, cur   *game.State
+		want        []game.EventType
+		wantSummary string // of the rank event, if any
+	}{
+		{
+			name: "new match",
+			prev: mustState(t, playerState{MatchID: "KR_1", Rank: gold}),
+			cur:  mustState(t, playerState{MatchID: "KR_2", Rank: goldMoreLP}),
+			want: []game.EventType{game.EventMatchCompleted},
+		},
+		{
+			name:        "new match with promotion",
+			prev:        mustState(t, playerState{MatchID: "KR_1", Rank: gold}),
+			cur:         mustState(t, playerState{MatchID: "KR_2", Rank: platinum}),
+			want:        []game.EventType{game.EventMatchCompleted, game.EventRankChanged},
+			wantSummary: "GOLD I → PLATINUM IV",
+		},
+		{
+			// The league API caught up a poll after the match appeared
+			name:        "rank change after its match",
+			prev:        mustState(t, playerState{MatchID: "KR_2", Rank: gold}),
+			cur:         mustState(t, playerState{MatchID: "KR_2", Rank: platinum}),
+			want:        []game.EventType{game.EventRankChanged},
+			wantSummary: "GOLD I → PLATINUM IV",
+		},
+		{
+			name: "LP change only",
+			prev: mustState(t, playerState{MatchID: "KR_2", Rank: gold}),
+			cur:  mustState(t, playerState{MatchID: "KR_2", Rank: goldMoreLP}),
+		},
+		{
+			name: "first placement",
+			prev: mustState(t, playerState{MatchID: "KR_1"}),
+			cur:  mustState(t, playerState{MatchID: "KR_1", Rank: gold}),
+		},
+		{
+			name: "legacy previous state",
+			prev: game.LegacyState("KR_1"),
+			cur:  mustState(t, playerState{MatchID: "KR_2", Rank: gold}),
+			want: []game.EventType{game.EventMatchCompleted},
+		},
+		{
+			name: "no match yet",
+			prev: mustState(t, playerState{}),
+			cur:  mustState(t, playerState{}),
+		},
+	}
+
+	tracker := NewTracker("test-key")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := tracker.CompareStates(tt.prev, tt.cur)
+			if err != nil {
+				t.Fatalf("CompareStates: %v", err)
+			}
+			var got []game.EventType
+			for _, e := range events {
+				got = append(got, e.Type)
+				if e.Type == game.EventRankChanged && e.Summary != tt.wantSummary {
+					t.Errorf("rank summary = %q, want %q", e.Summary, tt.wantSummary)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateStanding(t *testing.T) {
+	tracker := NewTracker("test-key")
+
+	standing, err := tracker.StateStanding(mustState(t, playerState{MatchID: "KR_1", Rank: &rankState{Tier: "GOLD", Rank: "II", LP: 45}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if standing == nil || *standing != (game.Standing{Tier: "GOLD", Division: "II", LP: 45}) {
+		t.Errorf("StateStanding = %+v, want GOLD II 45LP", standing)
+	}
+
+	standing, err = tracker.StateStanding(game.LegacyState("KR_1"))
+	if err != nil || standing != nil {
+		t.Errorf("StateStanding(legacy) = %+v, %v; want unranked", standing, err)
+	}
+}
+
+func TestFormatTraits(t *testing.T) {
+	traits := []riot.TFTTrait{
+		{Name: "TFT13_Sorcerer", NumUnits: 6},
+		{Name: "Set13_Bruiser", NumUnits: 4},
+	}
+	if got, want := formatTraits(traits), "Sorcerer 6 · Bruiser 4"; got != want {
+		t.Errorf("formatTraits = %q, want %q", got, want)
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	units := []riot.TFTUnit{
+		{CharacterID: "TFT13_Jinx", Tier: 2, Rarity: 4},
+		{CharacterID: "TFT13_Vi", Tier: 3, Rarity: 1},
+		{CharacterID: "TFT13_Jayce", Tier: 1, Rarity: 6},
+		{CharacterID: "TFT13_Ekko", Tier: 2, Rarity: 4},
+	}
+	// Highest cost first; equal costs keep the board order
+	if got, want := formatUnits(units), "Jayce ★, Jinx ★★, Ekko ★★, Vi ★★★"; got != want {
+		t.Errorf("formatUnits = %q, want %q", got, want)
+	}
+	if units[0].CharacterID != "TFT13_Jinx" {
+		t.Error("formatUnits reordered its input")
+	}
+}
+
+func mustState(t *testing.T, s playerState) *game.State {
+	t.Helper()
+	state, err := game.NewState(stateVersion, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
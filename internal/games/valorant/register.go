@@ -0,0 +1,18 @@
+package valorant
+
+import (
+	"github.com/flor3z/discord-bot/internal/config"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+func init() {
+	game.RegisterFactory(game.Factory{
+		Type:         game.GameTypeValorant,
+		Name:         "발로란트",
+		RequiredKeys: []string{"RIOT_API_KEY"},
+		New: func(cfg *config.Config) (game.Tracker, error) {
+			return NewTrackerWithClient(riot.SharedClient(cfg.RiotAPIKey)), nil
+		},
+	})
+}
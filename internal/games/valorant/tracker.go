@@ -0,0 +1,405 @@
+package valorant
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+const (
+	// contentTTL is how long fetched content is reused before refreshing
+	contentTTL = 24 * time.Hour
+
+	// stateVersion is the current version of matchState
+	stateVersion = 1
+)
+
+// contentLocales are the Riot content locales of agent and map names
+var contentLocales = map[i18n.Locale]string{
+	i18n.Korean:   "ko-KR",
+	i18n.English:  "en-US",
+	i18n.Japanese: "ja-JP",
+}
+
+// matchState is the tracked state of a Valorant player
+type matchState struct {
+	MatchID string `json:"match_id"`
+}
+
+// Tracker implements game.Tracker for Valorant
+type Tracker struct {
+	client *riot.Client
+
+	// Cached VAL-Content data for agent and map names
+	mu          sync.Mutex
+	content     *riot.VALContent
+	contentTime time.Time
+}
+
+// NewTracker creates a new Valorant tracker
+func NewTracker(apiKey string) *Tracker {
+	return NewTrackerWithClient(riot.NewClient(apiKey))
+}
+
+// NewTrackerWithClient creates a Valorant tracker using the given Riot client,
+// which may point at a local fake server via riot.NewClientWithBaseURLs
+func NewTrackerWithClient(client *riot.Client) *Tracker {
+	return &Tracker{
+		client: client,
+	}
+}
+
+// Name returns the human-readable name of the game
+func (t *Tracker) Name() string {
+	return "발로란트"
+}
+
+// Type returns the game type identifier
+func (t *Tracker) Type() game.GameType {
+	return game.GameTypeValorant
+}
+
+// Description returns a brief description of the game
+func (t *Tracker) Description() string {
+	return "발로란트 경기 결과 및 경쟁전 티어 추적"
+}
+
+// ValidatePlayerID validates the Riot ID format
+func (t *Tracker) ValidatePlayerID(input string) error {
+	parts := strings.Split(input, "#")
+	if len(parts) != 2 {
+		return i18n.Errorf("valorant.invalid_format")
+	}
+
+	if strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return i18n.Errorf("valorant.empty_name")
+	}
+
+	return nil
+}
+
+// ResolvePlayer looks up player information from the Riot Account API
+func (t *Tracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
+	parts := strings.Split(input, "#")
+	if len(parts) != 2 {
+		return nil, i18n.Errorf("lol.invalid_riot_id")
+	}
+
+	account, err := t.client.GetAccountByRiotID(ctx, strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.player_not_found")
+	}
+
+	return &game.PlayerInfo{
+		ID:          account.PUUID,
+		DisplayName: fmt.Sprintf("%s#%s", account.GameName, account.TagLine),
+		GameType:    game.GameTypeValorant,
+	}, nil
+}
+
+// GetCurrentState returns the latest Valorant match ID for change detection
+func (t *Tracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
+	matchlist, err := t.client.GetVALMatchlist(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matchlist.History) == 0 {
+		return nil, nil
+	}
+
+	return game.NewState(stateVersion, matchState{MatchID: recentMatchIDs(matchlist)[0]})
+}
+
+// recentMatchIDs returns the match IDs of a matchlist, newest first
+// History is not guaranteed to be sorted
+func recentMatchIDs(matchlist *riot.VALMatchlist) []string {
+	history := slices.Clone(matchlist.History)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].GameStartTimeMillis > history[j].GameStartTimeMillis
+	})
+
+	ids := make([]string, len(history))
+	for i, entry := range history {
+		ids[i] = entry.MatchID
+	}
+	return ids
+}
+
+// decodeState decodes a stored state, upgrading legacy match ID strings
+func decodeState(state *game.State) (matchState, error) {
+	if matchID, ok := state.Legacy(); ok {
+		return matchState{MatchID: matchID}, nil
+	}
+
+	var s matchState
+	if err := state.Decode(&s); err != nil {
+		return matchState{}, err
+	}
+	return s, nil
+}
+
+// CompareStates reports a completed match when the latest match ID changes
+func (t *Tracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	before, err := decodeState(prev)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeState(cur)
+	if err != nil {
+		return nil, err
+	}
+
+	if after.MatchID == "" || after.MatchID == before.MatchID {
+		return nil, nil
+	}
+
+	return []game.Event{
+		{Type: game.EventMatchCompleted, Summary: after.MatchID},
+	}, nil
+}
+
+// CreateNotification fetches match details and creates a Discord embed
+func (t *Tracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	l := i18n.FromContext(ctx)
+	state, err := decodeState(change.Current)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := t.client.GetVALMatch(ctx, state.MatchID)
+	if err != nil {
+		return nil, i18n.Wrap(err, "error.match_unavailable")
+	}
+
+	player := match.FindPlayer(playerID)
+	if player == nil || player.Stats == nil {
+		return &discordgo.MessageEmbed{
+			Title:       l.T("valorant.match_result"),
+			Description: l.T("lol.player_not_in_match"),
+			Color:       0xFF0000,
+		}, nil
+	}
+
+	return createMatchEmbed(l, playerName, match, player, t.getContent(ctx)), nil
+}
+
+// MatchResults returns the matches completed since the previous state for
+// recap history, including games played between polls
+func (t *Tracker) MatchResults(ctx context.Context, playerID string, change game.StateChange) ([]game.MatchResult, error) {
+	state, err := decodeState(change.Current)
+	if err != nil {
+		return nil, err
+	}
+	var previous matchState
+	if change.Previous != nil {
+		if previous, err = decodeState(change.Previous); err != nil {
+			return nil, err
+		}
+	}
+
+	matchlist, err := t.client.GetVALMatchlist(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	matchIDs := recentMatchIDs(matchlist)
+	matchIDs = matchIDs[:min(len(matchIDs), game.MaxRecordedMatches)]
+
+	var results []game.MatchResult
+	for _, matchID := range game.NewMatchIDs(matchIDs, previous.MatchID, state.MatchID) {
+		result, ok, err := t.matchResult(ctx, playerID, matchID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to get match result", "matchID", matchID, "error", err)
+			continue
+		}
+		if ok {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// matchResult returns a player's result in one match; ok is false if they didn't play in it
+func (t *Tracker) matchResult(ctx context.Context, playerID, matchID string) (result game.MatchResult, ok bool, err error) {
+	match, err := t.client.GetVALMatch(ctx, matchID)
+	if err != nil {
+		return result, false, fmt.Errorf("failed to get match: %w", err)
+	}
+
+	p := match.FindPlayer(playerID)
+	if p == nil || p.Stats == nil {
+		return result, false, nil
+	}
+
+	agent := p.CharacterID
+	if content := t.getContent(ctx); content != nil {
+		if c := content.FindCharacter(p.CharacterID); c != nil {
+			agent = c.DisplayName(contentLocales[i18n.FromContext(ctx)])
+		}
+	}
+
+	team := match.FindTeam(p.TeamID)
+	return game.MatchResult{
+		MatchID:   matchID,
+		Win:       team != nil && team.Won,
+		Kills:     p.Stats.Kills,
+		Deaths:    p.Stats.Deaths,
+		Assists:   p.Stats.Assists,
+		Character: agent,
+		PlayedAt:  time.UnixMilli(match.MatchInfo.GameStartMillis + match.MatchInfo.GameLengthMillis),
+	}, true, nil
+}
+
+// getContent returns cached VAL-Content data in every locale, refreshing it when stale
+// Returns nil if content is unavailable; names then fall back to raw IDs
+func (t *Tracker) getContent(ctx context.Context) *riot.VALContent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.content != nil && time.Since(t.contentTime) < contentTTL {
+		return t.content
+	}
+
+	content, err := t.client.GetVALContent(ctx, "")
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get Valorant content", "error", err)
+		return t.content
+	}
+
+	t.content = content
+	t.contentTime = time.Now()
+	return content
+}
+
+// createMatchEmbed creates a Discord embed for a Valorant match notification
+func createMatchEmbed(l i18n.Locale, playerName string, match *riot.VALMatch, p *riot.VALPlayer, content *riot.VALContent) *discordgo.MessageEmbed {
+	color := 0xE74C3C // Red for loss
+	resultText := l.T("lol.defeat")
+	var score string
+	if team := match.FindTeam(p.TeamID); team != nil {
+		if team.Won {
+			color = 0x2ECC71 // Green for win
+			resultText = l.T("lol.victory")
+		}
+		score = fmt.Sprintf("%d : %d", team.RoundsWon, team.RoundsPlayed-team.RoundsWon)
+	}
+	if isDraw(match) {
+		color = 0x95A5A6 // Grey for draw
+		resultText = l.T("valorant.draw")
+	}
+
+	agent := p.CharacterID
+	mapName := mapNameFromPath(match.MatchInfo.MapID)
+	if content != nil {
+		if c := content.FindCharacter(p.CharacterID); c != nil {
+			agent = c.DisplayName(contentLocales[l])
+		}
+		if m := content.FindMap(match.MatchInfo.MapID); m != nil {
+			mapName = m.DisplayName(contentLocales[l])
+		}
+	}
+
+	stats := p.Stats
+	kd := float64(stats.Kills) / float64(max(stats.Deaths, 1))
+	acs := 0
+	if stats.RoundsPlayed > 0 {
+		acs = stats.Score / stats.RoundsPlayed
+	}
+
+	minutes := match.MatchInfo.GameLengthMillis / 60000
+	seconds := match.MatchInfo.GameLengthMillis / 1000 % 60
+
+	embed := &discordgo.MessageEmbed{
+		Title: resultText,
+		Color: color,
+		Author: &discordgo.MessageEmbedAuthor{
+			Name: playerName,
+		},
+		Description: fmt.Sprintf("**%s** | %s | %s", agent, mapName, riot.GetVALQueueName(match.MatchInfo.QueueID)),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   l.T("valorant.score"),
+				Value:  valueOrDash(score),
+				Inline: true,
+			},
+			{
+				Name:   "K/D/A",
+				Value:  fmt.Sprintf("%d / %d / %d (%.2f)", stats.Kills, stats.Deaths, stats.Assists, kd),
+				Inline: true,
+			},
+			{
+				Name:   "ACS",
+				Value:  fmt.Sprintf("%d", acs),
+				Inline: true,
+			},
+			{
+				Name:   l.T("valorant.headshots"),
+				Value:  fmt.Sprintf("%.1f%%", match.HeadshotRate(p.PUUID)*100),
+				Inline: true,
+			},
+			{
+				Name:   l.T("lol.duration"),
+				Value:  fmt.Sprintf("%d:%02d", minutes, seconds),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("lol.match_id", match.MatchInfo.MatchID),
+		},
+		Timestamp: time.UnixMilli(match.MatchInfo.GameStartMillis + match.MatchInfo.GameLengthMillis).Format(time.RFC3339),
+	}
+
+	if match.MatchInfo.QueueID == "competitive" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   l.T("valorant.competitive_tier"),
+			Value:  riot.GetVALTierName(p.CompetitiveTier),
+			Inline: true,
+		})
+	}
+
+	return embed
+}
+
+// isDraw reports whether no team won the match
+func isDraw(match *riot.VALMatch) bool {
+	if len(match.Teams) == 0 {
+		return false
+	}
+	for _, team := range match.Teams {
+		if team.Won {
+			return false
+		}
+	}
+	return true
+}
+
+// mapNameFromPath derives a map name from its asset path (e.g. "/Game/Maps/Ascent/Ascent")
+func mapNameFromPath(path string) string {
+	if idx := strings.LastIndex(path, "/"); idx >= 0 {
+		return path[idx+1:]
+	}
+	return path
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// ProfileURL returns the player's tracker.gg page
+func (t *Tracker) ProfileURL(playerID, playerName string) string {
+	return fmt.Sprintf("https://tracker.gg/valorant/profile/riot/%s/overview", url.PathEscape(playerName))
+}
@@ -0,0 +1,318 @@
+package valorant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/riot"
+)
+
+const testPUUID = "puuid-1"
+
+// fakeRiot serves the Riot endpoints used by the Valorant tracker
+type fakeRiot struct {
+	mu      sync.Mutex
+	history []riot.VALMatchlistEntry
+	status  int // non-zero answers every request with this status
+}
+
+func (f *fakeRiot) setHistory(history ...riot.VALMatchlistEntry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.history = history
+}
+
+func (f *fakeRiot) setStatus(status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+}
+
+func (f *fakeRiot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.status != 0 {
+		http.Error(w, `{"status":{"message":"error"}}`, f.status)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/riot/account/v1/accounts/by-riot-id/TenZ/0505":
+		writeJSON(w, riot.Account{PUUID: testPUUID, GameName: "TenZ", TagLine: "0505"})
+	case strings.HasPrefix(r.URL.Path, "/val/match/v1/matchlists/by-puuid/"):
+		writeJSON(w, riot.VALMatchlist{PUUID: testPUUID, History: f.history})
+	case strings.HasPrefix(r.URL.Path, "/val/match/v1/matches/"):
+		writeJSON(w, testMatch(strings.TrimPrefix(r.URL.Path, "/val/match/v1/matches/")))
+	case r.URL.Path == "/val/content/v1/contents":
+		writeJSON(w, riot.VALContent{
+			Characters: []riot.VALContentItem{{Name: "Jett", ID: "agent-jett", LocalizedNames: map[string]string{"ja-JP": "ジェット"}}},
+			Maps:       []riot.VALContentItem{{Name: "Ascent", AssetPath: "/Game/Maps/Ascent/Ascent", LocalizedNames: map[string]string{"ja-JP": "アセント"}}},
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// testMatch returns a won competitive match of the test player
+func testMatch(matchID string) riot.VALMatch {
+	return riot.VALMatch{
+		MatchInfo: riot.VALMatchInfo{
+			MatchID:          matchID,
+			MapID:            "/Game/Maps/Ascent/Ascent",
+			GameLengthMillis: 35*60000 + 12000,
+			GameStartMillis:  1700000000000,
+			QueueID:          "competitive",
+		},
+		Players: []riot.VALPlayer{{
+			PUUID:           testPUUID,
+			TeamID:          "Red",
+			CharacterID:     "agent-jett",
+			CompetitiveTier: 21,
+			Stats:           &riot.VALPlayerStats{Score: 6240, RoundsPlayed: 24, Kills: 22, Deaths: 15, Assists: 4},
+		}},
+		Teams: []riot.VALTeam{
+			{TeamID: "Red", Won: true, RoundsPlayed: 24, RoundsWon: 13},
+			{TeamID: "Blue", Won: false, RoundsPlayed: 24, RoundsWon: 11},
+		},
+	}
+}
+
+func newTestTracker(t *testing.T) (*Tracker, *fakeRiot) {
+	t.Helper()
+	fake := &fakeRiot{}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return NewTrackerWithClient(riot.NewClientWithBaseURLs("test-key", server.URL, server.URL)), fake
+}
+
+func TestResolvePlayer(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+
+	player, err := tracker.ResolvePlayer(context.Background(), "TenZ#0505")
+	if err != nil {
+		t.Fatalf("ResolvePlayer: %v", err)
+	}
+	if player.ID != testPUUID || player.DisplayName != "TenZ#0505" || player.GameType != game.GameTypeValorant {
+		t.Errorf("ResolvePlayer = %+v", player)
+	}
+
+	if _, err := tracker.ResolvePlayer(context.Background(), "Nobody#0000"); err == nil {
+		t.Error("ResolvePlayer of an unknown account: want error")
+	}
+}
+
+func TestGetCurrentStatePicksLatestMatch(t *testing.T) {
+	tracker, fake := newTestTracker(t)
+	fake.setHistory(
+		riot.VALMatchlistEntry{MatchID: "old", GameStartTimeMillis: 100},
+		riot.VALMatchlistEntry{MatchID: "latest", GameStartTimeMillis: 300},
+		riot.VALMatchlistEntry{MatchID: "middle", GameStartTimeMillis: 200},
+	)
+
+	state, err := tracker.GetCurrentState(context.Background(), testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	s, err := decodeState(state)
+	if err != nil {
+		t.Fatalf("decodeState: %v", err)
+	}
+	if s.MatchID != "latest" {
+		t.Errorf("MatchID = %q, want %q", s.MatchID, "latest")
+	}
+}
+
+func TestGetCurrentStateEmptyHistory(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+
+	state, err := tracker.GetCurrentState(context.Background(), testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	if state != nil {
+		t.Errorf("state = %+v, want nil without any match", state)
+	}
+}
+
+func TestPollingReportsOnlyNewMatches(t *testing.T) {
+	ctx := context.Background()
+	tracker, fake := newTestTracker(t)
+	fake.setHistory(riot.VALMatchlistEntry{MatchID: "match-1", GameStartTimeMillis: 100})
+
+	// The first poll only records a baseline
+	baseline, err := tracker.GetCurrentState(ctx, testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	again, err := tracker.GetCurrentState(ctx, testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	events, err := tracker.CompareStates(baseline, again)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("events without a new match = %+v, want none", events)
+	}
+
+	fake.setHistory(
+		riot.VALMatchlistEntry{MatchID: "match-1", GameStartTimeMillis: 100},
+		riot.VALMatchlistEntry{MatchID: "match-2", GameStartTimeMillis: 200},
+	)
+	current, err := tracker.GetCurrentState(ctx, testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	events, err = tracker.CompareStates(baseline, current)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != game.EventMatchCompleted || events[0].Summary != "match-2" {
+		t.Fatalf("events = %+v, want one completed match-2", events)
+	}
+
+	change := game.StateChange{Previous: baseline, Current: current, Events: events}
+	embed, err := tracker.CreateNotification(ctx, testPUUID, "TenZ#0505", change)
+	if err != nil {
+		t.Fatalf("CreateNotification: %v", err)
+	}
+	if embed.Color != 0x2ECC71 {
+		t.Errorf("Color = %#x, want win colour", embed.Color)
+	}
+	if !strings.Contains(embed.Description, "Jett") || !strings.Contains(embed.Description, "Ascent") {
+		t.Errorf("Description = %q, want agent and map names from content", embed.Description)
+	}
+	if !strings.Contains(embed.Footer.Text, "match-2") {
+		t.Errorf("Footer = %q, want the match ID", embed.Footer.Text)
+	}
+
+	results, err := tracker.MatchResults(ctx, testPUUID, change)
+	if err != nil {
+		t.Fatalf("MatchResults: %v", err)
+	}
+	if len(results) != 1 || !results[0].Win || results[0].Kills != 22 || results[0].Character != "Jett" {
+		t.Errorf("MatchResults = %+v", results)
+	}
+}
+
+func TestMatchResultsIncludeMatchesBetweenPolls(t *testing.T) {
+	ctx := context.Background()
+	tracker, fake := newTestTracker(t)
+	fake.setHistory(riot.VALMatchlistEntry{MatchID: "match-1", GameStartTimeMillis: 100})
+	baseline, err := tracker.GetCurrentState(ctx, testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+
+	// Three games were played since the last poll
+	fake.setHistory(
+		riot.VALMatchlistEntry{MatchID: "match-3", GameStartTimeMillis: 300},
+		riot.VALMatchlistEntry{MatchID: "match-1", GameStartTimeMillis: 100},
+		riot.VALMatchlistEntry{MatchID: "match-4", GameStartTimeMillis: 400},
+		riot.VALMatchlistEntry{MatchID: "match-2", GameStartTimeMillis: 200},
+	)
+	current, err := tracker.GetCurrentState(ctx, testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+
+	results, err := tracker.MatchResults(ctx, testPUUID, game.StateChange{Previous: baseline, Current: current})
+	if err != nil {
+		t.Fatalf("MatchResults: %v", err)
+	}
+	var ids []string
+	for _, r := range results {
+		ids = append(ids, r.MatchID)
+	}
+	if got := strings.Join(ids, ","); got != "match-4,match-3,match-2" {
+		t.Errorf("recorded matches = %s, want every match since match-1", got)
+	}
+}
+
+func TestNotificationFollowsLocale(t *testing.T) {
+	tracker, fake := newTestTracker(t)
+	fake.setHistory(riot.VALMatchlistEntry{MatchID: "match-1", GameStartTimeMillis: 100})
+
+	current, err := tracker.GetCurrentState(context.Background(), testPUUID)
+	if err != nil {
+		t.Fatalf("GetCurrentState: %v", err)
+	}
+	change := game.StateChange{Current: current}
+
+	ctx := i18n.WithLocale(context.Background(), i18n.Japanese)
+	embed, err := tracker.CreateNotification(ctx, testPUUID, "TenZ#0505", change)
+	if err != nil {
+		t.Fatalf("CreateNotification: %v", err)
+	}
+	if embed.Title != i18n.Japanese.T("lol.victory") {
+		t.Errorf("Title = %q, want the Japanese result", embed.Title)
+	}
+	if !strings.Contains(embed.Description, "ジェット") || !strings.Contains(embed.Description, "アセント") {
+		t.Errorf("Description = %q, want Japanese agent and map names", embed.Description)
+	}
+
+	results, err := tracker.MatchResults(ctx, testPUUID, change)
+	if err != nil {
+		t.Fatalf("MatchResults: %v", err)
+	}
+	if len(results) != 1 || results[0].Character != "ジェット" {
+		t.Errorf("MatchResults = %+v, want the Japanese agent name", results)
+	}
+}
+
+func TestLegacyStateUpgrade(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+
+	current, err := game.NewState(stateVersion, matchState{MatchID: "match-1"})
+	if err != nil {
+		t.Fatalf("NewState: %v", err)
+	}
+	events, err := tracker.CompareStates(game.LegacyState("match-1"), current)
+	if err != nil {
+		t.Fatalf("CompareStates: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("events = %+v, want none for the same match stored in the legacy format", events)
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		notFound bool
+	}{
+		{name: "not found", status: http.StatusNotFound, notFound: true},
+		{name: "rate limited", status: http.StatusTooManyRequests},
+		{name: "server error", status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker, fake := newTestTracker(t)
+			fake.setStatus(tt.status)
+
+			_, err := tracker.GetCurrentState(context.Background(), testPUUID)
+			if err == nil {
+				t.Fatal("GetCurrentState: want error")
+			}
+			if got := errors.Is(err, riot.ErrNotFound); got != tt.notFound {
+				t.Errorf("errors.Is(err, riot.ErrNotFound) = %v, want %v (err: %v)", got, tt.notFound, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// catalogs holds the messages of every supported locale
+var catalogs = map[Locale]map[string]string{
+	Korean:   ko,
+	English:  en,
+	Japanese: ja,
+}
+
+// Validate checks that every locale has the same keys with matching format
+// verbs, so translations take the same arguments as the default locale
+// Slash command keys are only required in the non-default locales, since
+// command definitions are written in the default locale
+func Validate() error {
+	keys := make(map[string]bool)
+	for _, catalog := range catalogs {
+		for key := range catalog {
+			keys[key] = true
+		}
+	}
+
+	var problems []string
+	for key := range keys {
+		base, inDefault := catalogs[Default][key]
+		isCommand := strings.HasPrefix(key, commandKeyPrefix)
+		if !inDefault && !isCommand {
+			problems = append(problems, fmt.Sprintf("%s: missing in %s", key, Default))
+			continue
+		}
+
+		for _, l := range Supported() {
+			if l == Default {
+				continue
+			}
+			msg, ok := catalogs[l][key]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: missing in %s", key, l))
+				continue
+			}
+			if !inDefault {
+				continue
+			}
+			if got, want := formatVerbs(msg), formatVerbs(base); got != want {
+				problems = append(problems, fmt.Sprintf("%s: %s has format verbs %q, %s has %q",
+					key, l, got, Default, want))
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+	return fmt.Errorf("i18n catalog is inconsistent:\n%s", strings.Join(problems, "\n"))
+}
+
+// formatVerbs lists the fmt verbs of a message in argument order, e.g.
+// "%s: %d%%" gives "s d"; flags, width and precision are ignored and
+// explicit indexes such as "%[2]s" place a verb at that argument
+func formatVerbs(msg string) string {
+	verbs := make(map[int]byte)
+	arg := 1
+	for i := 0; i < len(msg); i++ {
+		if msg[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(msg) && msg[i] == '%' {
+			continue
+		}
+		for i < len(msg) && strings.IndexByte("+-# 0123456789.", msg[i]) >= 0 {
+			i++
+		}
+		if i < len(msg) && msg[i] == '[' {
+			end := strings.IndexByte(msg[i:], ']')
+			if end > 0 {
+				if n, err := strconv.Atoi(msg[i+1 : i+end]); err == nil {
+					arg = n
+				}
+				i += end + 1
+			}
+		}
+		if i < len(msg) {
+			verbs[arg] = msg[i]
+			arg++
+		}
+	}
+
+	indexes := make([]int, 0, len(verbs))
+	for n := range verbs {
+		indexes = append(indexes, n)
+	}
+	sort.Ints(indexes)
+
+	list := make([]string, len(indexes))
+	for i, n := range indexes {
+		list[i] = string(verbs[n])
+	}
+	return strings.Join(list, " ")
+}
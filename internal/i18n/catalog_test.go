@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	if err := Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEveryKeyInEveryLocale(t *testing.T) {
+	for _, l := range Supported() {
+		for _, other := range Supported() {
+			for key := range catalogs[l] {
+				// Command text defaults to the definitions, written in Korean
+				if other == Default && strings.HasPrefix(key, commandKeyPrefix) {
+					continue
+				}
+				if _, ok := catalogs[other][key]; !ok {
+					t.Errorf("%s: in %s but missing in %s", key, l, other)
+				}
+			}
+		}
+	}
+}
+
+func TestFormatVerbsMatch(t *testing.T) {
+	for key, base := range catalogs[Default] {
+		want := formatVerbs(base)
+		for _, l := range Supported() {
+			msg, ok := catalogs[l][key]
+			if !ok {
+				continue
+			}
+			if got := formatVerbs(msg); got != want {
+				t.Errorf("%s: %s has verbs %q, %s has %q\n  %s: %q\n  %s: %q",
+					key, l, got, Default, want, l, msg, Default, base)
+			}
+		}
+	}
+}
+
+func TestFormatVerbs(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"no verbs", ""},
+		{"100%%", ""},
+		{"%s: %d%%", "s d"},
+		{"+%.3f%% (%5d)", "f d"},
+		{"%-10s|%+d|%#x", "s d x"},
+		{"%[2]s then %[1]d", "d s"},
+		{"%[2]s %s", "s s"},
+		{"trailing %", ""},
+	}
+	for _, tt := range tests {
+		if got := formatVerbs(tt.msg); got != tt.want {
+			t.Errorf("formatVerbs(%q) = %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+}
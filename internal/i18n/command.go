@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandKeyPrefix prefixes the catalog keys of slash command text
+// A command "등록" with option "게임" uses "cmd.등록", "cmd.등록.desc",
+// "cmd.등록.게임" and "cmd.등록.게임.desc"; choices use "cmd.등록.게임.<value>"
+const commandKeyPrefix = "cmd."
+
+// LocalizeCommand fills the Discord name and description localizations of a
+// command, its options and their choices from the catalogs
+// The definition itself is in the default locale
+// Returns the name and description keys some locale doesn't translate;
+// choices without a translation are left as is
+func LocalizeCommand(cmd *discordgo.ApplicationCommand) []string {
+	key := commandKeyPrefix + cmd.Name
+	var missing []string
+	cmd.NameLocalizations = localizations(key, &missing)
+	cmd.DescriptionLocalizations = localizations(key+".desc", &missing)
+	localizeOptions(key, cmd.Options, &missing)
+	return missing
+}
+
+// localizeOptions localizes options recursively under a key
+func localizeOptions(parent string, options []*discordgo.ApplicationCommandOption, missing *[]string) {
+	for _, opt := range options {
+		key := parent + "." + opt.Name
+		opt.NameLocalizations = *localizations(key, missing)
+		opt.DescriptionLocalizations = *localizations(key+".desc", missing)
+		for _, choice := range opt.Choices {
+			choice.NameLocalizations = *localizations(fmt.Sprintf("%s.%v", key, choice.Value), nil)
+		}
+		localizeOptions(key, opt.Options, missing)
+	}
+}
+
+// localizations returns the Discord localization map of a key
+// Locales lacking the key are appended to missing when it is non-nil
+func localizations(key string, missing *[]string) *map[discordgo.Locale]string {
+	m := make(map[discordgo.Locale]string)
+	for _, l := range Supported() {
+		if l == Default {
+			continue
+		}
+		msg, ok := catalogs[l][key]
+		if !ok {
+			if missing != nil {
+				*missing = append(*missing, fmt.Sprintf("%s (%s)", key, l))
+			}
+			continue
+		}
+		for _, d := range discordLocales[l] {
+			m[d] = msg
+		}
+	}
+	return &m
+}
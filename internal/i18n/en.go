@@ -0,0 +1,666 @@
+package i18n
+
+// en is the English catalog
+var en = map[string]string{
+	// Games
+	"game.lol":             "League of Legends",
+	"game.lol.desc":        "Tracks League of Legends match results",
+	"game.tft":             "Teamfight Tactics",
+	"game.tft.desc":        "Tracks Teamfight Tactics (TFT) match results and ranked LP",
+	"game.valorant":        "VALORANT",
+	"game.valorant.desc":   "Tracks VALORANT match results and competitive tiers",
+	"game.steam":           "Steam",
+	"game.steam.desc":      "Tracks Steam achievements, playtime and new games (public profile required)",
+	"game.maplestory":      "MapleStory",
+	"game.maplestory.desc": "Tracks MapleStory character level, EXP and equipment",
+
+	// Common errors
+	"error.unknown_game":        "Unknown game: `%s`. Use `/games` to see the supported games.",
+	"error.invalid_player":      "Invalid player ID",
+	"error.internal":            "Something went wrong while running the command. Please try again later. (Reference: `%s`)",
+	"error.forbidden":           "You don't have permission to use this command.",
+	"error.cooldown":            "Please try again in %d seconds.",
+	"error.guild_only":          "This command can only be used in a server.",
+	"error.player_not_found":    "Player not found",
+	"error.match_unavailable":   "Couldn't fetch the match",
+	"error.matches_unavailable": "Couldn't fetch the match list",
+
+	// /register
+	"register.not_found":           "Couldn't find player `%s`. Check the ID and try again.",
+	"register.already_registered":  "Player `%s` is already registered for %s.",
+	"register.failed":              "Failed to register the player. Please try again.",
+	"register.already_tracking":    "Player `%s` is already tracked for %s in this server.",
+	"register.subscription_failed": "The player was saved but the subscription couldn't be created.",
+	"register.success":             "Now tracking `%s` for %s!",
+	"register.success_dm":          "Now tracking `%s` for %s! Updates will be sent to you by DM.",
+	"register.already_following":   "You already follow `%s` for %s.",
+
+	// /unregister
+	"unregister.not_registered": "Player `%s` isn't registered for %s.",
+	"unregister.failed":         "Failed to unregister the player. Please try again.",
+	"unregister.success":        "Stopped tracking `%s` for %s.",
+
+	// /list
+	"list.failed":   "Failed to get the player list.",
+	"list.empty":    "No players are registered in this server.\nAdd one with `/register`!\nUse `/games` to see the supported games.",
+	"list.title":    "**Registered players:**",
+	"list.empty_dm": "You aren't following any players.\nAdd one with `/register` to get DM notifications!",
+	"list.title_dm": "**Players you follow (DM notifications):**",
+
+	// /set-channel
+	"setchannel.failed":  "Failed to set the notification channel. Please try again.",
+	"setchannel.success": "Game notifications will be sent to <#%s>",
+
+	// /games
+	"games.none":           "No games are currently supported.",
+	"games.enabled":        "**Supported games:**",
+	"games.disabled":       "**Disabled games:**",
+	"games.hint":           "Start tracking with `/register game:<game> player:<ID>`!",
+	"games.default_desc":   "Tracks %s status changes",
+	"games.missing_config": "%s not set",
+	"games.init_failed":    "Failed to start",
+
+	// /recent
+	"recent.not_registered": "Player `%s` isn't registered for %s. Register them with `/register` first.",
+	"recent.no_data":        "There's no recent data for `%s` yet. Please try again later.",
+	"recent.failed":         "Failed to get recent data for `%s`.",
+
+	// /language
+	"language.set":     "This server's bot language is now **%s**.",
+	"language.auto":    "The bot now replies in each member's Discord language. Notifications are sent in Korean.",
+	"language.current": "This server's bot language: **%s**",
+	"language.failed":  "Failed to set the language. Please try again.",
+
+	// /방해금지
+	"quiet.set":              "🌙 Quiet hours: **%02d:00 - %02d:00** (%s). DM notifications won't be sent during this time.",
+	"quiet.off":              "Quiet hours turned off.",
+	"quiet.same_hours":       "Start and end hours can't be the same.",
+	"quiet.invalid_timezone": "Unknown time zone: `%s` (e.g. Asia/Seoul)",
+	"quiet.failed":           "Failed to save quiet hours. Please try again.",
+	"quiet.set_guild":        "🌙 Quiet hours for this server: **%02d:00 - %02d:00** (%s). Notifications during this time are %s.",
+	"quiet.off_guild":        "Quiet hours turned off for this server.",
+	"quiet.mode.digest":      "posted as a single digest afterwards",
+	"quiet.mode.drop":        "not sent",
+
+	// /알림묶음
+	"burst.set":    "When more than %d notifications arrive within a minute, the rest are collapsed into one summary.",
+	"burst.off":    "Notifications are no longer collapsed.",
+	"burst.failed": "Failed to save the burst limit. Please try again.",
+
+	// /내플레이어
+	"link.not_tracked":  "Player `%s` isn't tracked in %s. Register them with `/register` first.",
+	"link.taken":        "`%s` is already linked to another member.",
+	"link.taken_verify": "`%s` is already linked to another member. If it's your account, verify it with `/link-account`.",
+	"link.success":      "🔗 Linked `%s` (%s) to your account. You'll be mentioned in notifications with a mention rule.",
+	"link.not_linked":   "`%s` isn't linked to your account.",
+	"link.removed":      "Unlinked `%s` from your account.",
+	"link.failed":       "Failed to save the player link. Please try again.",
+
+	// /연동
+	"verify.unsupported":  "%s doesn't support account verification.",
+	"verify.already":      "`%s` is already verified as yours.",
+	"verify.title":        "🔐 Verify `%s`",
+	"verify.next":         "Once it's changed, run `/link-account check` within %d minutes.",
+	"verify.no_challenge": "You have no verification in progress, or it expired. Start again with `/link-account start`.",
+	"verify.mismatch":     "Not verified yet. %s\nIt may take a moment for the change to show up.",
+	"verify.success":      "✅ `%s` (%s) is verified and linked to your account! You'll now get its notifications by DM and can mute or unmute it in servers yourself.",
+
+	// /프로필
+	"profile.title":       "%s's profile",
+	"profile.empty":       "%s has no linked game accounts. Link one with `/my-player link` or `/link-account`.",
+	"profile.failed":      "Couldn't load the profile. Please try again.",
+	"profile.ranked":      "**%s** %s · %dW %dL (%.0f%% win rate)",
+	"profile.unranked":    "**%s** Unranked",
+	"profile.recent":      "Last %d games %dW %dL · KDA %.2f",
+	"profile.unavailable": "Couldn't load details",
+	"profile.no_details":  "Nothing to show yet",
+
+	// /전적, /게임중, /통계
+	"lookup.unsupported": "%s doesn't support this lookup.",
+	"lookup.failed":      "Couldn't get details for `%s`",
+	"lookup.no_data":     "There's nothing to show for `%s`.",
+	"lookup.not_playing": "`%s` is not in a game right now.",
+
+	// /멘션
+	"mention.added":                      "Mention rule added: %s",
+	"mention.deleted":                    "Mention rule deleted: %s",
+	"mention.empty":                      "No mention rules are set. Notifications don't mention anyone.",
+	"mention.title":                      "**Mention rules:**",
+	"mention.no_rule":                    "There is no mention rule #%d. Check the numbers with `/mention list`.",
+	"mention.role_or_member":             "Choose either a role or a member, not both.",
+	"mention.failed":                     "Failed to save the mention rule. Please try again.",
+	"mention.all_games":                  "All games",
+	"mention.owner":                      "Linked player",
+	"mention.event.promoted":             "Rank promotion",
+	"mention.event.rank_changed":         "Rank change",
+	"mention.event.pentakill":            "Pentakill",
+	"mention.event.level_up":             "Level up",
+	"mention.event.achievement_unlocked": "Achievement",
+	"mention.event.new_game":             "New game",
+	"mention.event.match_completed":      "Every match",
+
+	// /랭킹
+	"leaderboard.failed":            "Couldn't load the leaderboard. Please try again.",
+	"leaderboard.pin_forbidden":     "Pinning a leaderboard requires the Manage Server permission.",
+	"leaderboard.post_failed":       "Couldn't post the leaderboard. Please check the bot's channel permissions.",
+	"leaderboard.pin_failed":        "Pinned the leaderboard, but couldn't set up automatic updates.",
+	"leaderboard.pinned":            "Pinned the leaderboard. It updates every check cycle; delete the message to stop updates.",
+	"leaderboard.pinned_footer":     "Auto-updating · delete the message to stop updates",
+	"leaderboard.title":             "🏆 %s leaderboard",
+	"leaderboard.title_games":       " (last %d games)",
+	"leaderboard.footer":            "Page %d / %d · %d players",
+	"leaderboard.winrate_entry":     "%.0f%% (%d games, %d wins)",
+	"leaderboard.kda_entry":         "%.2f (%d games)",
+	"leaderboard.exp_entry":         "+%s EXP",
+	"leaderboard.empty_rank":        "No players have a rank yet. Ranks are refreshed on the next check cycle.",
+	"leaderboard.empty_games":       "No players have %d or more recorded games. Games are recorded from registration onward.",
+	"leaderboard.empty_weekly_exp":  "No MapleStory characters have growth records this week.",
+	"leaderboard.empty":             "No players to show.",
+	"leaderboard.metric.rank":       "Rank",
+	"leaderboard.metric.winrate":    "Win rate",
+	"leaderboard.metric.kda":        "Average KDA",
+	"leaderboard.metric.level":      "MapleStory level",
+	"leaderboard.metric.weekly_exp": "Weekly EXP",
+
+	// /리포트, /리포트설정
+	"report.failed":               "Couldn't build the recap. Please try again.",
+	"report.settings_unavailable": "Couldn't load the recap settings.",
+	"report.settings_failed":      "Couldn't save the recap settings. Please try again.",
+	"report.invalid_timezone":     "Unknown timezone: `%s` (e.g. Asia/Seoul)",
+	"report.weekly":               "Weekly recap: every %s at %s",
+	"report.weekly_off":           "Weekly recap: off",
+	"report.monthly":              "Monthly recap: on the 1st at %s",
+	"report.monthly_off":          "Monthly recap: off",
+	"report.channel_hint":         "Recaps are sent to the channel set with `/set-channel`.",
+	"report.title_weekly":         "📊 Weekly recap (%s ~ %s)",
+	"report.title_monthly":        "📊 Monthly recap (%s ~ %s)",
+	"report.footer":               "Based on the records of registered players",
+	"report.no_activity":          "No activity was recorded in this period.",
+	"report.mvp_weekly":           "Player of the week",
+	"report.mvp_monthly":          "Player of the month",
+	"report.more_players":         "%d more",
+	"report.idle_players":         "%d inactive players omitted",
+	"report.record_short":         "%d games, %d wins (%.0f%%)",
+	"report.levels_gained":        "Level +%.2f",
+	"report.record":               "%d games: %d W / %d L (%.0f%% win rate)",
+	"report.best":                 "Best: %s",
+	"report.worst":                "Worst: %s",
+	"report.most_played":          "Most played: %s (%d games)",
+	"report.win":                  "W",
+	"report.loss":                 "L",
+	"weekday.sunday":              "Sunday",
+	"weekday.monday":              "Monday",
+	"weekday.tuesday":             "Tuesday",
+	"weekday.wednesday":           "Wednesday",
+	"weekday.thursday":            "Thursday",
+	"weekday.friday":              "Friday",
+	"weekday.saturday":            "Saturday",
+
+	// /알림경로
+	"route.added":                   "Added a notification route: %s",
+	"route.deleted":                 "Deleted the notification route: %s",
+	"route.title":                   "**Notification routes:**",
+	"route.empty":                   "No notification routes are set. Notifications go to the channel set with `/set-channel`.",
+	"route.fallback_hint":           "Games without a matching route go to the `/set-channel` channel.",
+	"route.no_route":                "There is no notification route #%d. Check the numbers with `/routes list`.",
+	"route.unavailable":             "Couldn't load the notification routes.",
+	"route.failed":                  "Couldn't add the notification route. Please try again.",
+	"route.delete_failed":           "Couldn't delete the notification route. Please try again.",
+	"route.invalid_discord_webhook": "Invalid Discord webhook URL. It must look like `https://discord.com/api/webhooks/...`.",
+	"route.invalid_avatar":          "The avatar URL must start with `https://`.",
+	"route.invalid_webhook":         "The webhook URL must start with `https://`.",
+	"route.private_webhook":         "The webhook host can't be resolved or points to a private network. Please use a public address.",
+	"route.invalid_slack":           "Invalid Slack webhook URL. It must look like `https://hooks.slack.com/...`.",
+	"route.invalid_telegram":        "Enter a numeric Telegram chat ID or an `@channel` name.",
+	"route.telegram_claim":          "To confirm you can post in the Telegram chat `%s`, invite the bot to the chat, send the message below there as is, then run this command again within %d minutes.\n```\n%s\n```",
+	"route.telegram_not_posted":     "Couldn't find `%s` in the chat `%s`. Make sure the bot is in the chat, send the message, then try again.",
+	"route.telegram_unavailable":    "Couldn't check the Telegram chat. Please try again later.",
+	"route.all_games":               "All games",
+	"route.username":                "(name: %s)",
+	"route.sink.channel":            "Channel",
+	"route.sink.discord_webhook":    "Discord webhook",
+	"route.sink.webhook":            "JSON webhook",
+	"route.sink.slack":              "Slack",
+	"route.sink.telegram":           "Telegram",
+
+	// /캐릭터, /성장
+	"character.invalid_name": "Invalid character name",
+	"character.not_found":    "Couldn't find character `%s`.",
+	"character.failed":       "Couldn't get the character info of `%s`.",
+	"growth.not_registered":  "Character `%s` isn't registered. Register it with `/register` first.",
+	"growth.no_period":       "There is no period to show yet.",
+	"growth.failed":          "Couldn't get the growth history.",
+	"growth.not_enough":      "Not enough growth history for `%s` yet. Please try again later.",
+	"growth.chart_failed":    "Couldn't draw the growth chart.",
+	"growth.title_weekly":    "📈 Weekly growth",
+	"growth.title_monthly":   "📈 Monthly growth",
+	"growth.total":           "Total EXP gained",
+	"growth.daily_average":   "Daily average",
+	"growth.daily":           "EXP gained per day",
+	"growth.span":            " (%d days)",
+
+	// Digests
+	"notify.digest_title": "🌙 %d notifications during quiet hours",
+	"notify.burst_title":  "📦 Summary of %d notifications",
+	"notify.digest_more":  "…and %d more",
+	"notify.view_details": "View details",
+	"notify.image":        "Image",
+
+	// Notification buttons
+	"button.scoreboard":     "Full scoreboard",
+	"button.recent":         "Last %d games",
+	"button.mute":           "Mute",
+	"button.unmute":         "Unmute",
+	"button.profile":        "Profile",
+	"button.invalid":        "This button is invalid.",
+	"button.untracked":      "This player is no longer tracked.",
+	"button.game_disabled":  "This game is currently disabled.",
+	"button.no_details":     "This game doesn't support match details.",
+	"button.details_failed": "Couldn't fetch the match details: %s",
+	"button.not_subscribed": "This player isn't registered in this server.",
+	"button.mute_forbidden": "Only the member who registered the player, its verified owner or members with Manage Server can change notifications.",
+	"button.mute_failed":    "Failed to change the notification setting.",
+	"button.muted":          "🔕 Muted notifications for **%s**.",
+	"button.unmuted":        "🔔 Unmuted notifications for **%s**.",
+	"button.previous":       "◀ Previous",
+	"button.next":           "Next ▶",
+
+	// League of Legends
+	"lol.invalid_format":      "Invalid format: use Name#Tag (e.g. Faker#KR1)",
+	"lol.empty_name":          "The name and tag can't be empty",
+	"lol.invalid_riot_id":     "Invalid Riot ID",
+	"lol.match_result":        "Match result",
+	"lol.player_not_in_match": "The player wasn't found in the match data",
+	"lol.victory":             "Victory",
+	"lol.defeat":              "Defeat",
+	"lol.damage":              "Damage",
+	"lol.gold":                "Gold",
+	"lol.vision":              "Vision score",
+	"lol.duration":            "Duration",
+	"lol.match_id":            "Match ID: %s",
+	"lol.solo_rank":           "Solo/Duo",
+	"lol.pentakill":           "🔥 PENTAKILL!",
+	"lol.verify_icon":         "In the game client, change your summoner icon to **starter icon #%d** (pictured).",
+	"lol.scoreboard":          "Full scoreboard",
+	"lol.blue_team":           "Blue team",
+	"lol.red_team":            "Red team",
+	"lol.team_summary":        "%s (%s · %d kills)",
+	"lol.recent_title":        "Last %d games",
+	"lol.recent_none":         "No recent games.",
+	"lol.recent_summary":      "%dW %dL (%.0f%% win rate) · average KDA %.2f",
+	"lol.win_short":           "✅ W",
+	"lol.loss_short":          "❌ L",
+	"lol.live_title":          "🔴 In game",
+	"lol.live_loading":        "Loading",
+	"lol.live_elapsed":        "%d min in",
+	"lol.pentakill_count":     "🔥 Pentakill x%d (%s)",
+	"lol.rank_changed":        "🏅 Solo queue rank changed",
+
+	// TFT
+	"tft.ranked":       "Ranked",
+	"tft.match_result": "TFT match result",
+	"tft.rank_changed": "🏅 TFT rank changed",
+	"tft.placement":    "#%d",
+	"tft.level":        "Level",
+	"tft.damage":       "Player damage",
+	"tft.traits":       "Traits",
+	"tft.units":        "Units",
+	"tft.augments":     "Augments",
+
+	// Valorant
+	"valorant.invalid_format":   "Invalid format: use Name#Tag (e.g. TenZ#0505)",
+	"valorant.empty_name":       "The name and tag can't be empty",
+	"valorant.match_result":     "Valorant match result",
+	"valorant.draw":             "Draw",
+	"valorant.score":            "Score",
+	"valorant.headshots":        "Headshots",
+	"valorant.competitive_tier": "Competitive tier",
+
+	// Riot queues
+	"queue.400":    "Normal Draft",
+	"queue.420":    "Ranked Solo/Duo",
+	"queue.430":    "Normal Blind",
+	"queue.440":    "Ranked Flex",
+	"queue.450":    "ARAM",
+	"queue.900":    "URF",
+	"queue.1020":   "One for All",
+	"queue.1300":   "Nexus Blitz",
+	"queue.1400":   "Ultimate Spellbook",
+	"queue.1700":   "Arena",
+	"queue.custom": "Custom Game",
+
+	// Steam
+	"steam.playtime_jump":       "%s: +%.1fh (%dh total)",
+	"steam.invalid_profile_url": "Invalid profile URL",
+	"steam.invalid_player":      "Enter a SteamID64 (e.g. 76561197960287930), a custom URL name or a profile URL",
+	"steam.profile_unavailable": "Couldn't get the profile",
+	"steam.games_unavailable":   "Couldn't get the game library",
+	"steam.title_achievements":  "🏆 %d achievements unlocked",
+	"steam.title_new_games":     "🎮 New games",
+	"steam.title_playtime":      "⏱️ Playtime up",
+	"steam.title_profile":       "🎮 Steam profile",
+	"steam.new_games":           "🆕 New games",
+	"steam.playtime":            "⏱️ Playtime",
+	"steam.playing":             "Playing **%s**",
+	"steam.more_achievements":   "and %d more",
+	"steam.rarity":              "Rarity %s",
+	"steam.owned_games":         "Games owned",
+	"steam.game_count":          "%d",
+	"steam.total_playtime":      "Total playtime",
+	"steam.hours":               "%dh",
+	"steam.recently_played":     "Recently played",
+	"steam.recent_line":         "[%s](%s) — %.1fh in 2 weeks (%dh total)",
+
+	// Custom trackers
+	"custom.empty_player":   "Enter a player ID",
+	"custom.invalid_player": "Invalid player ID format (expected `%s`)",
+
+	// MapleStory
+	"maple.empty_name":            "The character name is empty",
+	"maple.name_too_long":         "The character name is too long (max 12 characters)",
+	"maple.character_not_found":   "Character not found",
+	"maple.character_unavailable": "Couldn't fetch the character",
+	"maple.title_status":          "📊 MapleStory character status",
+	"maple.title_level_up":        "🎉 MapleStory level up!",
+	"maple.title_gear":            "⚔️ MapleStory equipment change",
+	"maple.title_exp":             "📈 MapleStory EXP gained",
+	"maple.level":                 "Level",
+	"maple.exp":                   "EXP",
+	"maple.combat_power":          "Combat power",
+	"maple.gear_changes":          "Equipment changes",
+	"maple.exp_gained":            "EXP gained",
+	"maple.next_level":            "Next level in",
+	"maple.eta":                   "about %s",
+	"maple.levels_gained":         "(+%d levels)",
+	"maple.profile":               "Lv.%d (%s%%) · %s · %s",
+	"maple.exp_summary":           "EXP +%d",
+	"maple.gear_equipped":         "🆕 **%s** equipped %s",
+	"maple.gear_starforce_up":     "⭐ **%s** reached %d stars (%d → %d)",
+	"maple.gear_starforce_down":   "💥 **%s** star force dropped (%d → %d)",
+	"maple.gear_unequipped":       "➖ **%s** unequipped %s",
+	"maple.potential":             "Potential",
+	"maple.additional_potential":  "Bonus potential",
+	"maple.potential_grade":       "✨ **%s** %s %s → %s: %s",
+	"maple.potential_reset":       "🔄 **%s** %s reset (%s): %s",
+	"maple.no_grade":              "None",
+	"maple.power_eok_man":         "%d億 %d万",
+	"maple.power_eok":             "%d億",
+	"maple.power_man":             "%d万",
+	"maple.snapshot_unavailable":  "Couldn't fetch the character as of %s",
+	"maple.snapshot_empty":        "No character data for %s",
+	"maple.page.basic":            "Overview",
+	"maple.page.stat":             "Stats",
+	"maple.page.equipment":        "Equipment",
+	"maple.page.symbol":           "Symbols",
+	"maple.page.ability":          "Ability / Link skills",
+	"maple.page.guild":            "Guild",
+	"maple.page_footer":           "MapleStory · page %d/%d",
+	"maple.world":                 "World",
+	"maple.class":                 "Job",
+	"maple.class_value":           "%s (job advancement %s)",
+	"maple.gender":                "Gender",
+	"maple.created":               "Created",
+	"maple.popularity":            "Fame",
+	"maple.union":                 "Union",
+	"maple.stat_unavailable":      "Couldn't fetch the stats",
+	"maple.hyper_stat":            "Hyper stats (preset %s)",
+	"maple.equipment_unavailable": "Couldn't fetch the equipment",
+	"maple.no_equipment":          "No equipment is worn.",
+	"maple.symbol_unavailable":    "Couldn't fetch the symbols",
+	"maple.no_symbols":            "No symbols are equipped.",
+	"maple.symbol_value":          "Lv.%d (force %s)",
+	"maple.ability_unavailable":   "Couldn't fetch the ability",
+	"maple.ability":               "Ability (%s)",
+	"maple.link_skills":           "Link skills",
+	"maple.no_ability":            "No ability information.",
+	"maple.guild_unavailable":     "Couldn't fetch the guild",
+	"maple.no_guild":              "Not in a guild.",
+	"maple.guild_name":            "Guild name",
+	"maple.guild_master":          "Guild master",
+	"maple.guild_members":         "Members",
+	"maple.member_count":          "%d",
+	"maple.guild_fame":            "Fame",
+	"maple.guild_points":          "Points",
+
+	// Durations
+	"duration.days_hours":    "%dd %dh",
+	"duration.hours_minutes": "%dh %dm",
+	"duration.minutes":       "%dm",
+
+	// Nexon API errors
+	"nexon.bad_request":         "Bad request (HTTP 400): %s",
+	"nexon.forbidden":           "Forbidden (HTTP 403)",
+	"nexon.rate_limited":        "API rate limit exceeded (HTTP 429)",
+	"nexon.server_error":        "Internal server error (HTTP 500)",
+	"nexon.maintenance":         "API under maintenance (HTTP 503)",
+	"nexon.unknown":             "API error: HTTP %d, body: %s",
+	"nexon.character_not_found": "Character not found: %s",
+	"nexon.guild_not_found":     "Guild not found: %s",
+	"nexon.history_unavailable": "Data before %s isn't available",
+
+	// Slash commands
+	"cmd.등록":                "register",
+	"cmd.등록.desc":           "Register a player to track their game activity",
+	"cmd.등록.게임":             "game",
+	"cmd.등록.게임.desc":        "Game to track (e.g. lol)",
+	"cmd.등록.플레이어":           "player",
+	"cmd.등록.플레이어.desc":      "Player ID (e.g. Faker#KR1)",
+	"cmd.해제":                "unregister",
+	"cmd.해제.desc":           "Stop tracking a player's game activity",
+	"cmd.해제.게임":             "game",
+	"cmd.해제.게임.desc":        "Game (e.g. lol)",
+	"cmd.해제.플레이어":           "player",
+	"cmd.해제.플레이어.desc":      "Player ID (e.g. Faker#KR1)",
+	"cmd.목록":                "list",
+	"cmd.목록.desc":           "List every player registered in this server",
+	"cmd.채널설정":              "set-channel",
+	"cmd.채널설정.desc":         "Set the channel that receives game notifications",
+	"cmd.채널설정.채널":           "channel",
+	"cmd.채널설정.채널.desc":      "Channel to send notifications to",
+	"cmd.게임목록":              "games",
+	"cmd.게임목록.desc":         "List the trackable games",
+	"cmd.최근":                "recent",
+	"cmd.최근.desc":           "Show a player's most recent status",
+	"cmd.최근.게임":             "game",
+	"cmd.최근.게임.desc":        "Game to look up (e.g. lol)",
+	"cmd.최근.플레이어":           "player",
+	"cmd.최근.플레이어.desc":      "Player ID (e.g. Faker#KR1)",
+	"cmd.언어":                "language",
+	"cmd.언어.desc":           "Set the bot language of this server",
+	"cmd.언어.언어":             "language",
+	"cmd.언어.언어.desc":        "Language (leave empty to show the current one)",
+	"cmd.언어.언어.auto":        "Each member's Discord language",
+	"cmd.방해금지":              "quiet-hours",
+	"cmd.방해금지.desc":         "Pause notifications during a daily time window (server notifications in a server, your own in DMs)",
+	"cmd.방해금지.설정":           "set",
+	"cmd.방해금지.설정.desc":      "Set quiet hours",
+	"cmd.방해금지.설정.시작":        "start",
+	"cmd.방해금지.설정.시작.desc":   "Hour quiet hours begin (0-23)",
+	"cmd.방해금지.설정.종료":        "end",
+	"cmd.방해금지.설정.종료.desc":   "Hour quiet hours end (0-23)",
+	"cmd.방해금지.설정.시간대":       "timezone",
+	"cmd.방해금지.설정.시간대.desc":  "Time zone (default: Asia/Seoul)",
+	"cmd.방해금지.설정.모드":        "mode",
+	"cmd.방해금지.설정.모드.desc":   "What happens to server notifications (default: digest)",
+	"cmd.방해금지.설정.모드.digest": "Post a digest afterwards",
+	"cmd.방해금지.설정.모드.drop":   "Don't send them",
+	"cmd.알림묶음":              "burst-limit",
+	"cmd.알림묶음.desc":         "Collapse notifications into one summary when many arrive within a minute",
+	"cmd.알림묶음.개수":           "count",
+	"cmd.알림묶음.개수.desc":      "Notifications sent separately per minute (0: never collapse)",
+	"cmd.방해금지.해제":           "off",
+	"cmd.방해금지.해제.desc":      "Turn quiet hours off",
+
+	"cmd.내플레이어":              "my-player",
+	"cmd.내플레이어.desc":         "Link a tracked player to your account (to be mentioned by mention rules)",
+	"cmd.내플레이어.연결":           "link",
+	"cmd.내플레이어.연결.desc":      "Mark this player as you",
+	"cmd.내플레이어.연결.게임":        "game",
+	"cmd.내플레이어.연결.게임.desc":   "Game (e.g. lol)",
+	"cmd.내플레이어.연결.플레이어":      "player",
+	"cmd.내플레이어.연결.플레이어.desc": "Player ID (e.g. Faker#KR1)",
+	"cmd.내플레이어.해제":           "unlink",
+	"cmd.내플레이어.해제.desc":      "Unlink a player from your account",
+	"cmd.내플레이어.해제.게임":        "game",
+	"cmd.내플레이어.해제.게임.desc":   "Game (e.g. lol)",
+	"cmd.내플레이어.해제.플레이어":      "player",
+	"cmd.내플레이어.해제.플레이어.desc": "Player ID (e.g. Faker#KR1)",
+	"cmd.멘션":                             "mention",
+	"cmd.멘션.desc":                        "Manage rules that mention a role or member on certain notifications",
+	"cmd.멘션.추가":                          "add",
+	"cmd.멘션.추가.desc":                     "Add a mention rule (without a role or member, the linked player is mentioned)",
+	"cmd.멘션.추가.이벤트":                      "event",
+	"cmd.멘션.추가.이벤트.desc":                 "Event to mention on",
+	"cmd.멘션.추가.이벤트.promoted":             "Rank promotion",
+	"cmd.멘션.추가.이벤트.rank_changed":         "Rank change (promotion/demotion)",
+	"cmd.멘션.추가.이벤트.pentakill":            "Pentakill",
+	"cmd.멘션.추가.이벤트.level_up":             "Level up",
+	"cmd.멘션.추가.이벤트.achievement_unlocked": "Achievement",
+	"cmd.멘션.추가.이벤트.new_game":             "New game",
+	"cmd.멘션.추가.이벤트.match_completed":      "Every match",
+	"cmd.멘션.추가.역할":                       "role",
+	"cmd.멘션.추가.역할.desc":                  "Role to mention",
+	"cmd.멘션.추가.멤버":                       "member",
+	"cmd.멘션.추가.멤버.desc":                  "Member to mention",
+	"cmd.멘션.추가.게임":                       "game",
+	"cmd.멘션.추가.게임.desc":                  "Only apply to this game (default: all games)",
+	"cmd.멘션.목록":                          "list",
+	"cmd.멘션.목록.desc":                     "List this server's mention rules",
+	"cmd.멘션.삭제":                          "delete",
+	"cmd.멘션.삭제.desc":                     "Delete a mention rule",
+	"cmd.멘션.삭제.번호":                       "number",
+	"cmd.멘션.삭제.번호.desc":                  "Rule number from /mention list",
+
+	"cmd.연동":              "link-account",
+	"cmd.연동.desc":         "Prove a game account is yours and link it (DM alerts, muting it yourself)",
+	"cmd.연동.시작":           "start",
+	"cmd.연동.시작.desc":      "Start verifying; change the setting in game as instructed, then /link-account check",
+	"cmd.연동.시작.게임":        "game",
+	"cmd.연동.시작.게임.desc":   "Game",
+	"cmd.연동.시작.플레이어":      "player",
+	"cmd.연동.시작.플레이어.desc": "Your game account ID (e.g. Faker#KR1)",
+	"cmd.연동.확인":           "check",
+	"cmd.연동.확인.desc":      "Check the change in game and finish linking",
+	"cmd.프로필":             "profile",
+	"cmd.프로필.desc":        "Show a member's linked game accounts at a glance",
+	"cmd.프로필.멤버":          "member",
+	"cmd.프로필.멤버.desc":     "Member to show (default: you)",
+	"cmd.전적":              "history",
+	"cmd.전적.desc":         "Show a player's recent matches",
+	"cmd.전적.게임":           "game",
+	"cmd.전적.게임.desc":      "Game",
+	"cmd.전적.플레이어":         "player",
+	"cmd.전적.플레이어.desc":    "Player ID (e.g. Faker#KR1)",
+	"cmd.전적.경기수":          "count",
+	"cmd.전적.경기수.desc":     "Number of matches (default: 10)",
+	"cmd.게임중":             "live",
+	"cmd.게임중.desc":        "Show whether a player is in a game right now",
+	"cmd.게임중.게임":          "game",
+	"cmd.게임중.게임.desc":     "Game",
+	"cmd.게임중.플레이어":        "player",
+	"cmd.게임중.플레이어.desc":   "Player ID (e.g. Faker#KR1)",
+	"cmd.통계":              "stats",
+	"cmd.통계.desc":         "Show a player's detailed stats",
+	"cmd.통계.게임":           "game",
+	"cmd.통계.게임.desc":      "Game",
+	"cmd.통계.플레이어":         "player",
+	"cmd.통계.플레이어.desc":    "Player ID (e.g. Faker#KR1)",
+
+	"cmd.알림경로":                 "routes",
+	"cmd.알림경로.desc":            "Manage the channels and webhooks notifications go to (default: the /set-channel channel)",
+	"cmd.알림경로.채널":              "channel",
+	"cmd.알림경로.채널.desc":         "Send notifications to a channel as bot messages",
+	"cmd.알림경로.채널.채널":           "channel",
+	"cmd.알림경로.채널.채널.desc":      "Channel to send notifications to",
+	"cmd.알림경로.채널.게임":           "game",
+	"cmd.알림경로.채널.게임.desc":      "Only send this game's notifications (default: all games)",
+	"cmd.알림경로.디스코드웹훅":          "discord-webhook",
+	"cmd.알림경로.디스코드웹훅.desc":     "Send notifications to a Discord webhook (with a per-game name/avatar)",
+	"cmd.알림경로.디스코드웹훅.url":      "url",
+	"cmd.알림경로.디스코드웹훅.url.desc": "Webhook URL (https://discord.com/api/webhooks/...)",
+	"cmd.알림경로.디스코드웹훅.게임":       "game",
+	"cmd.알림경로.디스코드웹훅.게임.desc":  "Only send this game's notifications (default: all games)",
+	"cmd.알림경로.디스코드웹훅.이름":       "name",
+	"cmd.알림경로.디스코드웹훅.이름.desc":  "Webhook display name (default: the game name)",
+	"cmd.알림경로.디스코드웹훅.아바타":      "avatar",
+	"cmd.알림경로.디스코드웹훅.아바타.desc": "Webhook avatar image URL",
+	"cmd.알림경로.웹훅":              "webhook",
+	"cmd.알림경로.웹훅.desc":         "Send notifications as JSON to an external webhook",
+	"cmd.알림경로.웹훅.url":          "url",
+	"cmd.알림경로.웹훅.url.desc":     "Webhook URL (https://...)",
+	"cmd.알림경로.웹훅.게임":           "game",
+	"cmd.알림경로.웹훅.게임.desc":      "Only send this game's notifications (default: all games)",
+	"cmd.알림경로.슬랙":              "slack",
+	"cmd.알림경로.슬랙.desc":         "Send notifications to a Slack incoming webhook",
+	"cmd.알림경로.슬랙.url":          "url",
+	"cmd.알림경로.슬랙.url.desc":     "Slack webhook URL (https://hooks.slack.com/services/...)",
+	"cmd.알림경로.슬랙.게임":           "game",
+	"cmd.알림경로.슬랙.게임.desc":      "Only send this game's notifications (default: all games)",
+	"cmd.알림경로.텔레그램":            "telegram",
+	"cmd.알림경로.텔레그램.desc":       "Send notifications to a Telegram chat (invite the bot to the chat first)",
+	"cmd.알림경로.텔레그램.채팅":         "chat",
+	"cmd.알림경로.텔레그램.채팅.desc":    "Chat ID (e.g. -1001234567890) or @channelname",
+	"cmd.알림경로.텔레그램.게임":         "game",
+	"cmd.알림경로.텔레그램.게임.desc":    "Only send this game's notifications (default: all games)",
+	"cmd.알림경로.목록":              "list",
+	"cmd.알림경로.목록.desc":         "List this server's notification routes",
+	"cmd.알림경로.삭제":              "delete",
+	"cmd.알림경로.삭제.desc":         "Delete a notification route",
+	"cmd.알림경로.삭제.번호":           "number",
+	"cmd.알림경로.삭제.번호.desc":      "Route number from /routes list",
+
+	"cmd.리포트":            "report",
+	"cmd.리포트.desc":       "Show a weekly/monthly activity recap of the registered players",
+	"cmd.리포트.기간":         "period",
+	"cmd.리포트.기간.desc":    "Recap period (default: weekly)",
+	"cmd.리포트.기간.weekly":  "Weekly (last 7 days)",
+	"cmd.리포트.기간.monthly": "Monthly (last 30 days)",
+	"cmd.리포트설정":          "report-settings",
+	"cmd.리포트설정.desc":     "Set the day, time and timezone of the scheduled recaps",
+	"cmd.리포트설정.요일":       "weekday",
+	"cmd.리포트설정.요일.desc":  "Day of the weekly recap",
+	"cmd.리포트설정.요일.0":     "Sunday",
+	"cmd.리포트설정.요일.1":     "Monday",
+	"cmd.리포트설정.요일.2":     "Tuesday",
+	"cmd.리포트설정.요일.3":     "Wednesday",
+	"cmd.리포트설정.요일.4":     "Thursday",
+	"cmd.리포트설정.요일.5":     "Friday",
+	"cmd.리포트설정.요일.6":     "Saturday",
+	"cmd.리포트설정.시":        "hour",
+	"cmd.리포트설정.시.desc":   "Hour to send at (0-23)",
+	"cmd.리포트설정.분":        "minute",
+	"cmd.리포트설정.분.desc":   "Minute to send at (0-59)",
+	"cmd.리포트설정.시간대":      "timezone",
+	"cmd.리포트설정.시간대.desc": "IANA timezone (e.g. Asia/Seoul, America/Los_Angeles)",
+	"cmd.리포트설정.주간":       "weekly",
+	"cmd.리포트설정.주간.desc":  "Send weekly recaps",
+	"cmd.리포트설정.월간":       "monthly",
+	"cmd.리포트설정.월간.desc":  "Send monthly recaps (on the 1st)",
+
+	"cmd.랭킹":               "leaderboard",
+	"cmd.랭킹.desc":          "Rank the players registered in this server",
+	"cmd.랭킹.지표":            "metric",
+	"cmd.랭킹.지표.desc":       "Ranking criterion",
+	"cmd.랭킹.지표.rank":       "Rank",
+	"cmd.랭킹.지표.winrate":    "Win rate",
+	"cmd.랭킹.지표.kda":        "Average KDA",
+	"cmd.랭킹.지표.level":      "MapleStory level",
+	"cmd.랭킹.지표.weekly_exp": "Weekly EXP",
+	"cmd.랭킹.경기수":           "games",
+	"cmd.랭킹.경기수.desc":      "Recent games used for win rate/KDA (default: 20)",
+	"cmd.랭킹.고정":            "pin",
+	"cmd.랭킹.고정.desc":       "Pin to this channel and refresh every poll (requires Manage Server)",
+
+	"cmd.캐릭터":          "character",
+	"cmd.캐릭터.desc":     "Look up a MapleStory character",
+	"cmd.캐릭터.캐릭터":      "character",
+	"cmd.캐릭터.캐릭터.desc": "Character name",
+	"cmd.성장":           "growth",
+	"cmd.성장.desc":      "Chart a registered MapleStory character's level/EXP growth",
+	"cmd.성장.캐릭터":       "character",
+	"cmd.성장.캐릭터.desc":  "Character name",
+	"cmd.성장.기간":        "period",
+	"cmd.성장.기간.desc":   "Period (default: weekly)",
+	"cmd.성장.기간.7":      "Weekly (7 days)",
+	"cmd.성장.기간.30":     "Monthly (30 days)",
+}
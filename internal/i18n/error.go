@@ -0,0 +1,48 @@
+package i18n
+
+import "errors"
+
+// Error is a user-facing error rendered in the reader's locale
+// Error() renders it in the default locale
+type Error struct {
+	Key  string
+	Args []any
+	Err  error // optional cause, appended after the message
+}
+
+// Errorf returns an error with a catalog message
+func Errorf(key string, args ...any) *Error {
+	return &Error{Key: key, Args: args}
+}
+
+// Wrap returns an error with a catalog message and a cause
+func Wrap(err error, key string, args ...any) *Error {
+	return &Error{Key: key, Args: args, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Localize(Default)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Localize renders the error and its cause in a locale
+func (e *Error) Localize(l Locale) string {
+	msg := l.T(e.Key, e.Args...)
+	if e.Err != nil {
+		msg += ": " + Message(l, e.Err)
+	}
+	return msg
+}
+
+// Message renders any error for users in a locale
+// Errors without a catalog message are returned as is
+func Message(l Locale, err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Localize(l)
+	}
+	return err.Error()
+}
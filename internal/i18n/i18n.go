@@ -0,0 +1,107 @@
+// Package i18n holds the bot's message catalogs and locale resolution
+package i18n
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Locale is a language the bot can speak
+type Locale string
+
+// Supported locales
+const (
+	Korean   Locale = "ko"
+	English  Locale = "en"
+	Japanese Locale = "ja"
+)
+
+// Default is the locale used when nothing else is known
+// Catalog entries missing from another locale fall back to it
+const Default = Korean
+
+// Supported returns every locale with a catalog, default first
+func Supported() []Locale {
+	return []Locale{Korean, English, Japanese}
+}
+
+// nativeNames are locale names written in their own language
+var nativeNames = map[Locale]string{
+	Korean:   "한국어",
+	English:  "English",
+	Japanese: "日本語",
+}
+
+// discordLocales maps each locale to the Discord locales it serves
+var discordLocales = map[Locale][]discordgo.Locale{
+	Korean:   {discordgo.Korean},
+	English:  {discordgo.EnglishUS, discordgo.EnglishGB},
+	Japanese: {discordgo.Japanese},
+}
+
+// Parse returns the locale for a code such as "en"
+func Parse(code string) (Locale, bool) {
+	l := Locale(code)
+	_, ok := catalogs[l]
+	return l, ok
+}
+
+// FromDiscord returns the locale serving a Discord locale, if any
+func FromDiscord(locale discordgo.Locale) (Locale, bool) {
+	for l, discord := range discordLocales {
+		for _, d := range discord {
+			if d == locale {
+				return l, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Name returns the locale's name in its own language
+func (l Locale) Name() string {
+	if name, ok := nativeNames[l]; ok {
+		return name
+	}
+	return string(l)
+}
+
+// T returns the message for a key formatted with args
+// Missing messages fall back to the default locale, then to the key itself
+func (l Locale) T(key string, args ...any) string {
+	msg, ok := l.Lookup(key)
+	if !ok {
+		msg = key
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Lookup returns the unformatted message for a key
+// Missing messages fall back to the default locale
+func (l Locale) Lookup(key string) (string, bool) {
+	if msg, ok := catalogs[l][key]; ok {
+		return msg, true
+	}
+	msg, ok := catalogs[Default][key]
+	return msg, ok
+}
+
+type contextKey struct{}
+
+// WithLocale returns a context carrying the locale to render messages in
+func WithLocale(ctx context.Context, l Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the locale carried by a context, or Default
+func FromContext(ctx context.Context) Locale {
+	if l, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return l
+	}
+	return Default
+}
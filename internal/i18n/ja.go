@@ -0,0 +1,666 @@
+package i18n
+
+// ja is the Japanese catalog
+var ja = map[string]string{
+	// Games
+	"game.lol":             "リーグ・オブ・レジェンド",
+	"game.lol.desc":        "リーグ・オブ・レジェンドの試合結果を追跡",
+	"game.tft":             "チームファイト タクティクス",
+	"game.tft.desc":        "チームファイト タクティクス(TFT)の試合結果とランクLPを追跡",
+	"game.valorant":        "VALORANT",
+	"game.valorant.desc":   "VALORANTの試合結果とコンペティティブのランクを追跡",
+	"game.steam":           "Steam",
+	"game.steam.desc":      "Steamの実績、プレイ時間、新しいゲームを追跡 (公開プロフィールが必要)",
+	"game.maplestory":      "メイプルストーリー",
+	"game.maplestory.desc": "メイプルストーリーのキャラクターのレベル/経験値/装備を追跡",
+
+	// Common errors
+	"error.unknown_game":        "不明なゲーム: `%s`。`/ゲーム一覧` で対応ゲームを確認してください。",
+	"error.invalid_player":      "プレイヤーIDの形式が正しくありません",
+	"error.internal":            "コマンドの処理中にエラーが発生しました。しばらくしてからもう一度お試しください。(参照コード: `%s`)",
+	"error.forbidden":           "このコマンドを使用する権限がありません。",
+	"error.cooldown":            "%d秒後にもう一度お試しください。",
+	"error.guild_only":          "このコマンドはサーバー内でのみ使用できます。",
+	"error.player_not_found":    "プレイヤーが見つかりません",
+	"error.match_unavailable":   "試合情報を取得できません",
+	"error.matches_unavailable": "試合一覧を取得できません",
+
+	// /登録
+	"register.not_found":           "プレイヤー `%s` が見つかりません。IDを確認してもう一度お試しください。",
+	"register.already_registered":  "プレイヤー `%s` はすでに%sに登録されています。",
+	"register.failed":              "プレイヤーの登録に失敗しました。もう一度お試しください。",
+	"register.already_tracking":    "プレイヤー `%s` はこのサーバーですでに%sを追跡中です。",
+	"register.subscription_failed": "プレイヤーは保存されましたが、購読の作成に失敗しました。",
+	"register.success":             "`%s` の%s追跡を登録しました!",
+	"register.success_dm":          "`%s` の%s追跡を開始しました！新しい通知はDMでお送りします。",
+	"register.already_following":   "`%s` はすでに%sでフォローしています。",
+
+	// /解除
+	"unregister.not_registered": "プレイヤー `%s` は%sに登録されていません。",
+	"unregister.failed":         "プレイヤーの登録解除に失敗しました。もう一度お試しください。",
+	"unregister.success":        "`%s` の%s追跡を解除しました。",
+
+	// /一覧
+	"list.failed":   "プレイヤー一覧の取得に失敗しました。",
+	"list.empty":    "このサーバーに登録されたプレイヤーはいません。\n`/登録` で追加してください!\n`/ゲーム一覧` で対応ゲームを確認できます。",
+	"list.title":    "**登録済みプレイヤー:**",
+	"list.empty_dm": "フォロー中のプレイヤーはいません。\n`/登録` で追加するとDMで通知を受け取れます！",
+	"list.title_dm": "**フォロー中のプレイヤー (DM通知):**",
+
+	// /チャンネル設定
+	"setchannel.failed":  "通知チャンネルの設定に失敗しました。もう一度お試しください。",
+	"setchannel.success": "ゲーム通知は <#%s> に送信されます",
+
+	// /ゲーム一覧
+	"games.none":           "現在対応しているゲームはありません。",
+	"games.enabled":        "**対応ゲーム:**",
+	"games.disabled":       "**無効なゲーム:**",
+	"games.hint":           "`/登録 ゲーム:<ゲーム> プレイヤー:<ID>` で追跡を始めましょう!",
+	"games.default_desc":   "%sの状態変化を追跡",
+	"games.missing_config": "%s が未設定",
+	"games.init_failed":    "初期化に失敗",
+
+	// /最近
+	"recent.not_registered": "プレイヤー `%s` は%sに登録されていません。先に `/登録` で登録してください。",
+	"recent.no_data":        "`%s` の最近のデータはまだありません。しばらくしてからもう一度お試しください。",
+	"recent.failed":         "`%s` の最近のデータの取得に失敗しました。",
+
+	// /言語
+	"language.set":     "このサーバーのボット言語を **%s** に設定しました。",
+	"language.auto":    "ボットは各メンバーのDiscordの言語で応答します。通知は韓国語で送信されます。",
+	"language.current": "このサーバーのボット言語: **%s**",
+	"language.failed":  "言語の設定に失敗しました。もう一度お試しください。",
+
+	// /방해금지
+	"quiet.set":              "🌙 おやすみ時間: **%02d:00〜%02d:00** (%s)。この時間はDM通知を送りません。",
+	"quiet.off":              "おやすみ時間を解除しました。",
+	"quiet.same_hours":       "開始時刻と終了時刻を同じにすることはできません。",
+	"quiet.invalid_timezone": "不明なタイムゾーンです: `%s` (例: Asia/Seoul)",
+	"quiet.failed":           "おやすみ時間を保存できませんでした。もう一度お試しください。",
+	"quiet.set_guild":        "🌙 このサーバーのおやすみ時間: **%02d:00〜%02d:00** (%s)。この時間の通知は%s。",
+	"quiet.off_guild":        "このサーバーのおやすみ時間を解除しました。",
+	"quiet.mode.digest":      "終了後にまとめて送信します",
+	"quiet.mode.drop":        "送信しません",
+
+	// /알림묶음
+	"burst.set":    "1分間に通知が%d件を超えると、残りを1つのまとめにします。",
+	"burst.off":    "通知をまとめずにすべて個別に送信します。",
+	"burst.failed": "通知まとめの設定を保存できませんでした。もう一度お試しください。",
+
+	// /내플레이어
+	"link.not_tracked":  "プレイヤー `%s` は%sで追跡されていません。先に `/登録` で登録してください。",
+	"link.taken":        "`%s` はすでに別のメンバーと連携されています。",
+	"link.taken_verify": "`%s` はすでに別のメンバーと連携されています。あなたのアカウントなら `/アカウント連携` で認証してください。",
+	"link.success":      "🔗 `%s`（%s）をあなたのアカウントに連携しました。メンションルールのある通知であなたを呼び出します。",
+	"link.not_linked":   "`%s` はあなたのアカウントと連携されていません。",
+	"link.removed":      "`%s` とあなたのアカウントの連携を解除しました。",
+	"link.failed":       "プレイヤーの連携を保存できませんでした。もう一度お試しください。",
+
+	// /연동
+	"verify.unsupported":  "%sはアカウント認証に対応していません。",
+	"verify.already":      "`%s` はすでにあなたのアカウントとして認証済みです。",
+	"verify.title":        "🔐 `%s` のアカウント認証",
+	"verify.next":         "変更したら%d分以内に `/アカウント連携 確認` を実行してください。",
+	"verify.no_challenge": "進行中の認証がないか、期限切れです。`/アカウント連携 開始` からやり直してください。",
+	"verify.mismatch":     "まだ確認できません。%s\nゲームに反映されるまで少し時間がかかる場合があります。",
+	"verify.success":      "✅ `%s`（%s）が認証され、あなたのアカウントに連携されました！このプレイヤーの通知をDMで受け取り、サーバーで通知のオン/オフを自分で切り替えられます。",
+
+	// /프로필
+	"profile.title":       "%sさんのプロフィール",
+	"profile.empty":       "%sさんに連携されたゲームアカウントはありません。`/マイプレイヤー 連携` か `/アカウント連携` で連携してください。",
+	"profile.failed":      "プロフィールを読み込めませんでした。もう一度お試しください。",
+	"profile.ranked":      "**%s** %s · %d勝 %d敗 (勝率 %.0f%%)",
+	"profile.unranked":    "**%s** ランクなし",
+	"profile.recent":      "直近%d試合 %d勝 %d敗 · KDA %.2f",
+	"profile.unavailable": "情報を読み込めませんでした",
+	"profile.no_details":  "表示する情報がありません",
+
+	// /전적, /게임중, /통계
+	"lookup.unsupported": "%sはこの照会に対応していません。",
+	"lookup.failed":      "`%s`の情報を取得できませんでした",
+	"lookup.no_data":     "`%s`の表示できる情報がありません。",
+	"lookup.not_playing": "`%s`さんは今ゲーム中ではありません。",
+
+	// /멘션
+	"mention.added":                      "メンションルールを追加しました: %s",
+	"mention.deleted":                    "メンションルールを削除しました: %s",
+	"mention.empty":                      "メンションルールはありません。通知では誰も呼び出しません。",
+	"mention.title":                      "**メンションルール:**",
+	"mention.no_rule":                    "%d番のメンションルールはありません。`/メンション 一覧` で番号を確認してください。",
+	"mention.role_or_member":             "ロールとメンバーはどちらか一方だけ指定してください。",
+	"mention.failed":                     "メンションルールを保存できませんでした。もう一度お試しください。",
+	"mention.all_games":                  "すべてのゲーム",
+	"mention.owner":                      "連携した本人",
+	"mention.event.promoted":             "ランク昇格",
+	"mention.event.rank_changed":         "ランク変動",
+	"mention.event.pentakill":            "ペンタキル",
+	"mention.event.level_up":             "レベルアップ",
+	"mention.event.achievement_unlocked": "実績解除",
+	"mention.event.new_game":             "新しいゲーム",
+	"mention.event.match_completed":      "すべての試合",
+
+	// /랭킹
+	"leaderboard.failed":            "ランキングを読み込めませんでした。もう一度お試しください。",
+	"leaderboard.pin_forbidden":     "ランキングの固定にはサーバー管理権限が必要です。",
+	"leaderboard.post_failed":       "ランキングを送信できませんでした。ボットのチャンネル権限を確認してください。",
+	"leaderboard.pin_failed":        "ランキングを固定しましたが、自動更新の設定に失敗しました。",
+	"leaderboard.pinned":            "ランキングを固定しました。確認周期ごとに自動更新され、メッセージを削除すると更新が止まります。",
+	"leaderboard.pinned_footer":     "自動更新 · メッセージを削除すると更新が止まります",
+	"leaderboard.title":             "🏆 %s ランキング",
+	"leaderboard.title_games":       " (直近%d試合)",
+	"leaderboard.footer":            "%d / %d ページ · %d人",
+	"leaderboard.winrate_entry":     "%.0f%% (%d戦%d勝)",
+	"leaderboard.kda_entry":         "%.2f (%d試合)",
+	"leaderboard.exp_entry":         "+%s EXP",
+	"leaderboard.empty_rank":        "ランク情報のあるプレイヤーがいません。ランクは次の確認時に更新されます。",
+	"leaderboard.empty_games":       "記録された試合が%d試合以上のプレイヤーがいません。試合は登録後から記録されます。",
+	"leaderboard.empty_weekly_exp":  "今週の成長記録があるメイプルストーリーのキャラクターがいません。",
+	"leaderboard.empty":             "表示するプレイヤーがいません。",
+	"leaderboard.metric.rank":       "ランク",
+	"leaderboard.metric.winrate":    "勝率",
+	"leaderboard.metric.kda":        "平均KDA",
+	"leaderboard.metric.level":      "メイプルストーリーのレベル",
+	"leaderboard.metric.weekly_exp": "週間経験値",
+
+	// /리포트, /리포트설정
+	"report.failed":               "レポートを作成できませんでした。もう一度お試しください。",
+	"report.settings_unavailable": "レポート設定を読み込めませんでした。",
+	"report.settings_failed":      "レポート設定を保存できませんでした。もう一度お試しください。",
+	"report.invalid_timezone":     "不明なタイムゾーンです: `%s`(例: Asia/Tokyo)",
+	"report.weekly":               "週間レポート: 毎週%s %s",
+	"report.weekly_off":           "週間レポート: オフ",
+	"report.monthly":              "月間レポート: 毎月1日 %s",
+	"report.monthly_off":          "月間レポート: オフ",
+	"report.channel_hint":         "レポートは `/チャンネル設定` で指定したチャンネルに送信されます。",
+	"report.title_weekly":         "📊 週間レポート (%s ~ %s)",
+	"report.title_monthly":        "📊 月間レポート (%s ~ %s)",
+	"report.footer":               "登録されたプレイヤーの記録から集計しています",
+	"report.no_activity":          "この期間に記録された活動はありません。",
+	"report.mvp_weekly":           "今週のプレイヤー",
+	"report.mvp_monthly":          "今月のプレイヤー",
+	"report.more_players":         "他%d人",
+	"report.idle_players":         "活動のないプレイヤー%d人を除外",
+	"report.record_short":         "%d戦%d勝 (%.0f%%)",
+	"report.levels_gained":        "レベル +%.2f",
+	"report.record":               "%d戦%d勝%d敗 (勝率 %.0f%%)",
+	"report.best":                 "最高: %s",
+	"report.worst":                "最低: %s",
+	"report.most_played":          "最多: %s (%d試合)",
+	"report.win":                  "勝",
+	"report.loss":                 "敗",
+	"weekday.sunday":              "日曜日",
+	"weekday.monday":              "月曜日",
+	"weekday.tuesday":             "火曜日",
+	"weekday.wednesday":           "水曜日",
+	"weekday.thursday":            "木曜日",
+	"weekday.friday":              "金曜日",
+	"weekday.saturday":            "土曜日",
+
+	// /알림경로
+	"route.added":                   "通知ルートを追加しました: %s",
+	"route.deleted":                 "通知ルートを削除しました: %s",
+	"route.title":                   "**通知ルート:**",
+	"route.empty":                   "通知ルートはありません。通知は `/チャンネル設定` で指定したチャンネルに送信されます。",
+	"route.fallback_hint":           "一致するルートがないゲームは `/チャンネル設定` のチャンネルに送信されます。",
+	"route.no_route":                "%d番の通知ルートはありません。`/通知ルート 一覧` で番号を確認してください。",
+	"route.unavailable":             "通知ルートを読み込めませんでした。",
+	"route.failed":                  "通知ルートを追加できませんでした。もう一度お試しください。",
+	"route.delete_failed":           "通知ルートを削除できませんでした。もう一度お試しください。",
+	"route.invalid_discord_webhook": "Discord ウェブフック URL が正しくありません。`https://discord.com/api/webhooks/...` の形式にしてください。",
+	"route.invalid_avatar":          "アバター URL は `https://` で始まる必要があります。",
+	"route.invalid_webhook":         "ウェブフック URL は `https://` で始まる必要があります。",
+	"route.private_webhook":         "ウェブフックのホストを解決できないか、内部ネットワークを指しています。公開アドレスを使用してください。",
+	"route.invalid_slack":           "Slack ウェブフック URL が正しくありません。`https://hooks.slack.com/...` の形式にしてください。",
+	"route.invalid_telegram":        "Telegram のチャット ID(数字)または `@チャンネル名` を入力してください。",
+	"route.telegram_claim":          "Telegram チャット `%s` に投稿できることを確認します。ボットをチャットに招待し、下のメッセージをそのままチャットに送ってから、%d分以内にこのコマンドをもう一度実行してください。\n```\n%s\n```",
+	"route.telegram_not_posted":     "`%s` をチャット `%s` で見つけられませんでした。ボットがチャットにいることを確認し、メッセージを送ってからもう一度実行してください。",
+	"route.telegram_unavailable":    "Telegram チャットを確認できませんでした。しばらくしてからもう一度お試しください。",
+	"route.all_games":               "すべてのゲーム",
+	"route.username":                "(名前: %s)",
+	"route.sink.channel":            "チャンネル",
+	"route.sink.discord_webhook":    "Discord ウェブフック",
+	"route.sink.webhook":            "JSON ウェブフック",
+	"route.sink.slack":              "Slack",
+	"route.sink.telegram":           "Telegram",
+
+	// /캐릭터, /성장
+	"character.invalid_name": "キャラクター名が正しくありません",
+	"character.not_found":    "キャラクター `%s` が見つかりません。",
+	"character.failed":       "`%s` のキャラクター情報を取得できませんでした。",
+	"growth.not_registered":  "キャラクター `%s` は登録されていません。先に `/登録` で登録してください。",
+	"growth.no_period":       "表示できる期間がありません。",
+	"growth.failed":          "成長記録を取得できませんでした。",
+	"growth.not_enough":      "`%s` の成長記録がまだ足りません。しばらくしてからもう一度お試しください。",
+	"growth.chart_failed":    "成長グラフを作成できませんでした。",
+	"growth.title_weekly":    "📈 週間成長記録",
+	"growth.title_monthly":   "📈 月間成長記録",
+	"growth.total":           "総獲得経験値",
+	"growth.daily_average":   "1日平均",
+	"growth.daily":           "日別獲得経験値",
+	"growth.span":            " (%d日)",
+
+	// Digests
+	"notify.digest_title": "🌙 おやすみ時間中の通知 %d件",
+	"notify.burst_title":  "📦 通知 %d件のまとめ",
+	"notify.digest_more":  "…ほか%d件",
+	"notify.view_details": "詳細を見る",
+	"notify.image":        "画像",
+
+	// Notification buttons
+	"button.scoreboard":     "スコアボード",
+	"button.recent":         "直近%d試合",
+	"button.mute":           "通知オフ",
+	"button.unmute":         "通知オン",
+	"button.profile":        "プロフィール",
+	"button.invalid":        "無効なボタンです。",
+	"button.untracked":      "このプレイヤーはもう追跡されていません。",
+	"button.game_disabled":  "このゲームは現在無効です。",
+	"button.no_details":     "このゲームは試合情報に対応していません。",
+	"button.details_failed": "試合情報を取得できませんでした: %s",
+	"button.not_subscribed": "このサーバーに登録されていないプレイヤーです。",
+	"button.mute_forbidden": "通知を変更できるのは、プレイヤーを登録したメンバー、認証済みの本人、サーバー管理権限を持つメンバーだけです。",
+	"button.mute_failed":    "通知設定の変更中にエラーが発生しました。",
+	"button.muted":          "🔕 **%s** の通知をオフにしました。",
+	"button.unmuted":        "🔔 **%s** の通知をオンにしました。",
+	"button.previous":       "◀ 前へ",
+	"button.next":           "次へ ▶",
+
+	// League of Legends
+	"lol.invalid_format":      "形式が正しくありません: サモナー名#タグ の形式で入力してください (例: Faker#KR1)",
+	"lol.empty_name":          "サモナー名とタグは空にできません",
+	"lol.invalid_riot_id":     "Riot IDの形式が正しくありません",
+	"lol.match_result":        "試合結果",
+	"lol.player_not_in_match": "試合データにプレイヤーが見つかりません",
+	"lol.victory":             "勝利",
+	"lol.defeat":              "敗北",
+	"lol.damage":              "ダメージ",
+	"lol.gold":                "ゴールド",
+	"lol.vision":              "視界スコア",
+	"lol.duration":            "試合時間",
+	"lol.match_id":            "試合ID: %s",
+	"lol.solo_rank":           "ソロランク",
+	"lol.pentakill":           "🔥 ペンタキル！",
+	"lol.verify_icon":         "ゲームクライアントでサモナーアイコンを **初期アイコン%d番**（右の画像）に変更してください。",
+	"lol.scoreboard":          "スコアボード",
+	"lol.blue_team":           "ブルーチーム",
+	"lol.red_team":            "レッドチーム",
+	"lol.team_summary":        "%s (%s · %dキル)",
+	"lol.recent_title":        "直近%d試合",
+	"lol.recent_none":         "最近の試合記録はありません。",
+	"lol.recent_summary":      "%d勝 %d敗 (勝率 %.0f%%) · 平均KDA %.2f",
+	"lol.win_short":           "✅ 勝",
+	"lol.loss_short":          "❌ 敗",
+	"lol.live_title":          "🔴 ゲーム中",
+	"lol.live_loading":        "ロード中",
+	"lol.live_elapsed":        "開始から%d分",
+	"lol.pentakill_count":     "🔥 ペンタキル x%d (%s)",
+	"lol.rank_changed":        "🏅 ソロランク変動",
+
+	// TFT
+	"tft.ranked":       "ランク",
+	"tft.match_result": "TFT 試合結果",
+	"tft.rank_changed": "🏅 TFT ランク変動",
+	"tft.placement":    "%d位",
+	"tft.level":        "レベル",
+	"tft.damage":       "プレイヤーダメージ",
+	"tft.traits":       "シナジー",
+	"tft.units":        "ユニット",
+	"tft.augments":     "オーグメント",
+
+	// Valorant
+	"valorant.invalid_format":   "形式が正しくありません: 名前#タグ の形式で入力してください (例: TenZ#0505)",
+	"valorant.empty_name":       "名前とタグは空にできません",
+	"valorant.match_result":     "VALORANT 試合結果",
+	"valorant.draw":             "引き分け",
+	"valorant.score":            "スコア",
+	"valorant.headshots":        "ヘッドショット",
+	"valorant.competitive_tier": "コンペティティブランク",
+
+	// Riot queues
+	"queue.400":    "ノーマル (ドラフト)",
+	"queue.420":    "ランク ソロ/デュオ",
+	"queue.430":    "ノーマル (ブラインド)",
+	"queue.440":    "ランク フレックス",
+	"queue.450":    "ARAM",
+	"queue.900":    "URF",
+	"queue.1020":   "ワン・フォー・オール",
+	"queue.1300":   "ネクサスブリッツ",
+	"queue.1400":   "アルティメットスペルブック",
+	"queue.1700":   "アリーナ",
+	"queue.custom": "カスタムゲーム",
+
+	// Steam
+	"steam.playtime_jump":       "%s: +%.1f時間 (合計%d時間)",
+	"steam.invalid_profile_url": "プロフィール URL が正しくありません",
+	"steam.invalid_player":      "SteamID64(例: 76561197960287930)、カスタム URL 名、またはプロフィール URL を入力してください",
+	"steam.profile_unavailable": "プロフィールを取得できません",
+	"steam.games_unavailable":   "ゲーム一覧を取得できません",
+	"steam.title_achievements":  "🏆 実績を%d個解除",
+	"steam.title_new_games":     "🎮 新しいゲームを追加",
+	"steam.title_playtime":      "⏱️ プレイ時間が増加",
+	"steam.title_profile":       "🎮 Steam プロフィール",
+	"steam.new_games":           "🆕 新しいゲーム",
+	"steam.playtime":            "⏱️ プレイ時間",
+	"steam.playing":             "**%s** をプレイ中",
+	"steam.more_achievements":   "ほか%d個",
+	"steam.rarity":              "レア度 %s",
+	"steam.owned_games":         "所有ゲーム",
+	"steam.game_count":          "%d本",
+	"steam.total_playtime":      "総プレイ時間",
+	"steam.hours":               "%d時間",
+	"steam.recently_played":     "最近のプレイ",
+	"steam.recent_line":         "[%s](%s) — 2週間で%.1f時間 (合計%d時間)",
+
+	// Custom trackers
+	"custom.empty_player":   "プレイヤー ID を入力してください",
+	"custom.invalid_player": "プレイヤー ID の形式が正しくありません (形式: `%s`)",
+
+	// MapleStory
+	"maple.empty_name":            "キャラクター名が空です",
+	"maple.name_too_long":         "キャラクター名が長すぎます (最大12文字)",
+	"maple.character_not_found":   "キャラクターが見つかりません",
+	"maple.character_unavailable": "キャラクター情報を取得できません",
+	"maple.title_status":          "📊 メイプルストーリー キャラクター状態",
+	"maple.title_level_up":        "🎉 メイプルストーリー レベルアップ!",
+	"maple.title_gear":            "⚔️ メイプルストーリー 装備変更",
+	"maple.title_exp":             "📈 メイプルストーリー 経験値獲得",
+	"maple.level":                 "レベル",
+	"maple.exp":                   "経験値",
+	"maple.combat_power":          "戦闘力",
+	"maple.gear_changes":          "装備変更",
+	"maple.exp_gained":            "獲得経験値",
+	"maple.next_level":            "次のレベルまで",
+	"maple.eta":                   "約%s",
+	"maple.levels_gained":         "(+%dレベル)",
+	"maple.profile":               "Lv.%d (%s%%) · %s · %s",
+	"maple.exp_summary":           "経験値 +%d",
+	"maple.gear_equipped":         "🆕 **%s** %s を装備",
+	"maple.gear_starforce_up":     "⭐ **%s** %d星達成 (%d → %d)",
+	"maple.gear_starforce_down":   "💥 **%s** スターフォース下落 (%d → %d)",
+	"maple.gear_unequipped":       "➖ **%s** %s を解除",
+	"maple.potential":             "潜在能力",
+	"maple.additional_potential":  "アディショナル",
+	"maple.potential_grade":       "✨ **%s** %s %s → %s: %s",
+	"maple.potential_reset":       "🔄 **%s** %s 再設定 (%s): %s",
+	"maple.no_grade":              "なし",
+	"maple.power_eok_man":         "%d億%d万",
+	"maple.power_eok":             "%d億",
+	"maple.power_man":             "%d万",
+	"maple.snapshot_unavailable":  "%s時点のキャラクター情報を取得できません",
+	"maple.snapshot_empty":        "%sのキャラクターデータがありません",
+	"maple.page.basic":            "基本情報",
+	"maple.page.stat":             "ステータス",
+	"maple.page.equipment":        "装備",
+	"maple.page.symbol":           "シンボル",
+	"maple.page.ability":          "アビリティ / リンクスキル",
+	"maple.page.guild":            "ギルド",
+	"maple.page_footer":           "メイプルストーリー · %d/%dページ",
+	"maple.world":                 "ワールド",
+	"maple.class":                 "職業",
+	"maple.class_value":           "%s (%s次)",
+	"maple.gender":                "性別",
+	"maple.created":               "作成日",
+	"maple.popularity":            "人気度",
+	"maple.union":                 "ユニオン",
+	"maple.stat_unavailable":      "ステータス情報を取得できません",
+	"maple.hyper_stat":            "ハイパーステータス (プリセット%s)",
+	"maple.equipment_unavailable": "装備情報を取得できません",
+	"maple.no_equipment":          "装備しているアイテムがありません。",
+	"maple.symbol_unavailable":    "シンボル情報を取得できません",
+	"maple.no_symbols":            "装備しているシンボルがありません。",
+	"maple.symbol_value":          "Lv.%d (フォース %s)",
+	"maple.ability_unavailable":   "アビリティ情報を取得できません",
+	"maple.ability":               "アビリティ (%s)",
+	"maple.link_skills":           "リンクスキル",
+	"maple.no_ability":            "アビリティ情報がありません。",
+	"maple.guild_unavailable":     "ギルド情報を取得できません",
+	"maple.no_guild":              "加入しているギルドがありません。",
+	"maple.guild_name":            "ギルド名",
+	"maple.guild_master":          "ギルドマスター",
+	"maple.guild_members":         "人数",
+	"maple.member_count":          "%d人",
+	"maple.guild_fame":            "名声値",
+	"maple.guild_points":          "ポイント",
+
+	// Durations
+	"duration.days_hours":    "%d日%d時間",
+	"duration.hours_minutes": "%d時間%d分",
+	"duration.minutes":       "%d分",
+
+	// Nexon API errors
+	"nexon.bad_request":         "不正なリクエストです (HTTP 400): %s",
+	"nexon.forbidden":           "権限がありません (HTTP 403)",
+	"nexon.rate_limited":        "API呼び出し上限を超えました (HTTP 429)",
+	"nexon.server_error":        "サーバー内部エラー (HTTP 500)",
+	"nexon.maintenance":         "APIメンテナンス中 (HTTP 503)",
+	"nexon.unknown":             "APIエラー: HTTP %d, body: %s",
+	"nexon.character_not_found": "キャラクターが見つかりません: %s",
+	"nexon.guild_not_found":     "ギルドが見つかりません: %s",
+	"nexon.history_unavailable": "%sより前のデータは照会できません",
+
+	// Slash commands
+	"cmd.등록":                "登録",
+	"cmd.등록.desc":           "プレイヤーを登録してゲーム活動を追跡します",
+	"cmd.등록.게임":             "ゲーム",
+	"cmd.등록.게임.desc":        "追跡するゲーム (例: lol)",
+	"cmd.등록.플레이어":           "プレイヤー",
+	"cmd.등록.플레이어.desc":      "プレイヤーID (例: Faker#KR1)",
+	"cmd.해제":                "解除",
+	"cmd.해제.desc":           "プレイヤーのゲーム活動の追跡を停止します",
+	"cmd.해제.게임":             "ゲーム",
+	"cmd.해제.게임.desc":        "ゲーム (例: lol)",
+	"cmd.해제.플레이어":           "プレイヤー",
+	"cmd.해제.플레이어.desc":      "プレイヤーID (例: Faker#KR1)",
+	"cmd.목록":                "一覧",
+	"cmd.목록.desc":           "このサーバーに登録されたすべてのプレイヤー",
+	"cmd.채널설정":              "チャンネル設定",
+	"cmd.채널설정.desc":         "ゲーム通知を受け取るチャンネルを設定します",
+	"cmd.채널설정.채널":           "チャンネル",
+	"cmd.채널설정.채널.desc":      "通知を送るチャンネル",
+	"cmd.게임목록":              "ゲーム一覧",
+	"cmd.게임목록.desc":         "追跡できるゲームの一覧",
+	"cmd.최근":                "最近",
+	"cmd.최근.desc":           "プレイヤーの最新の状態を表示します",
+	"cmd.최근.게임":             "ゲーム",
+	"cmd.최근.게임.desc":        "表示するゲーム (例: lol)",
+	"cmd.최근.플레이어":           "プレイヤー",
+	"cmd.최근.플레이어.desc":      "プレイヤーID (例: Faker#KR1)",
+	"cmd.언어":                "言語",
+	"cmd.언어.desc":           "このサーバーのボット言語を設定します",
+	"cmd.언어.언어":             "言語",
+	"cmd.언어.언어.desc":        "言語 (空欄で現在の設定を表示)",
+	"cmd.언어.언어.auto":        "各メンバーのDiscordの言語",
+	"cmd.방해금지":              "おやすみ時間",
+	"cmd.방해금지.desc":         "毎日決まった時間に通知を止めます (サーバーではサーバー通知、DMでは個人通知)",
+	"cmd.방해금지.설정":           "設定",
+	"cmd.방해금지.설정.desc":      "おやすみ時間を設定します",
+	"cmd.방해금지.설정.시작":        "開始",
+	"cmd.방해금지.설정.시작.desc":   "開始する時 (0〜23)",
+	"cmd.방해금지.설정.종료":        "終了",
+	"cmd.방해금지.설정.종료.desc":   "終了する時 (0〜23)",
+	"cmd.방해금지.설정.시간대":       "タイムゾーン",
+	"cmd.방해금지.설정.시간대.desc":  "タイムゾーン (既定: Asia/Seoul)",
+	"cmd.방해금지.설정.모드":        "モード",
+	"cmd.방해금지.설정.모드.desc":   "サーバー通知の扱い (既定: まとめ)",
+	"cmd.방해금지.설정.모드.digest": "終了後にまとめて送信",
+	"cmd.방해금지.설정.모드.drop":   "送信しない",
+	"cmd.알림묶음":              "通知まとめ",
+	"cmd.알림묶음.desc":         "1分間に通知が集中したら残りを1つのまとめにします",
+	"cmd.알림묶음.개수":           "件数",
+	"cmd.알림묶음.개수.desc":      "1分間に個別に送る最大件数 (0: まとめない)",
+	"cmd.방해금지.해제":           "解除",
+	"cmd.방해금지.해제.desc":      "おやすみ時間を解除します",
+
+	"cmd.내플레이어":              "マイプレイヤー",
+	"cmd.내플레이어.desc":         "追跡中のプレイヤーをあなたのアカウントに連携します (メンションルールで呼び出し)",
+	"cmd.내플레이어.연결":           "連携",
+	"cmd.내플레이어.연결.desc":      "このプレイヤーが自分だと設定します",
+	"cmd.내플레이어.연결.게임":        "ゲーム",
+	"cmd.내플레이어.연결.게임.desc":   "ゲーム (例: lol)",
+	"cmd.내플레이어.연결.플레이어":      "プレイヤー",
+	"cmd.내플레이어.연결.플레이어.desc": "プレイヤーID (例: Faker#KR1)",
+	"cmd.내플레이어.해제":           "解除",
+	"cmd.내플레이어.해제.desc":      "プレイヤーとあなたのアカウントの連携を解除します",
+	"cmd.내플레이어.해제.게임":        "ゲーム",
+	"cmd.내플레이어.해제.게임.desc":   "ゲーム (例: lol)",
+	"cmd.내플레이어.해제.플레이어":      "プレイヤー",
+	"cmd.내플레이어.해제.플레이어.desc": "プレイヤーID (例: Faker#KR1)",
+	"cmd.멘션":                             "メンション",
+	"cmd.멘션.desc":                        "特定のイベントの通知でロールやメンバーを呼び出すルールを管理します",
+	"cmd.멘션.추가":                          "追加",
+	"cmd.멘션.추가.desc":                     "メンションルールを追加 (ロールとメンバーが空なら連携した本人を呼び出し)",
+	"cmd.멘션.추가.이벤트":                      "イベント",
+	"cmd.멘션.추가.이벤트.desc":                 "呼び出すイベント",
+	"cmd.멘션.추가.이벤트.promoted":             "ランク昇格",
+	"cmd.멘션.추가.이벤트.rank_changed":         "ランク変動 (昇格/降格)",
+	"cmd.멘션.추가.이벤트.pentakill":            "ペンタキル",
+	"cmd.멘션.추가.이벤트.level_up":             "レベルアップ",
+	"cmd.멘션.추가.이벤트.achievement_unlocked": "実績解除",
+	"cmd.멘션.추가.이벤트.new_game":             "新しいゲーム",
+	"cmd.멘션.추가.이벤트.match_completed":      "すべての試合",
+	"cmd.멘션.추가.역할":                       "ロール",
+	"cmd.멘션.추가.역할.desc":                  "呼び出すロール",
+	"cmd.멘션.추가.멤버":                       "メンバー",
+	"cmd.멘션.추가.멤버.desc":                  "呼び出すメンバー",
+	"cmd.멘션.추가.게임":                       "ゲーム",
+	"cmd.멘션.추가.게임.desc":                  "このゲームにだけ適用します (既定: すべてのゲーム)",
+	"cmd.멘션.목록":                          "一覧",
+	"cmd.멘션.목록.desc":                     "このサーバーのメンションルール一覧",
+	"cmd.멘션.삭제":                          "削除",
+	"cmd.멘션.삭제.desc":                     "メンションルールを削除します",
+	"cmd.멘션.삭제.번호":                       "番号",
+	"cmd.멘션.삭제.번호.desc":                  "/メンション 一覧 のルール番号",
+
+	"cmd.연동":              "アカウント連携",
+	"cmd.연동.desc":         "ゲームアカウントが自分のものだと認証して連携します (DM通知、通知のオン/オフ)",
+	"cmd.연동.시작":           "開始",
+	"cmd.연동.시작.desc":      "認証を開始します。案内に従ってゲーム内の設定を変えてから /アカウント連携 確認",
+	"cmd.연동.시작.게임":        "ゲーム",
+	"cmd.연동.시작.게임.desc":   "ゲーム",
+	"cmd.연동.시작.플레이어":      "プレイヤー",
+	"cmd.연동.시작.플레이어.desc": "自分のゲームアカウントID (例: Faker#KR1)",
+	"cmd.연동.확인":           "確認",
+	"cmd.연동.확인.desc":      "ゲーム内の変更を確認して連携を完了します",
+	"cmd.프로필":             "プロフィール",
+	"cmd.프로필.desc":        "メンバーが連携したゲームアカウントをまとめて表示します",
+	"cmd.프로필.멤버":          "メンバー",
+	"cmd.프로필.멤버.desc":     "表示するメンバー（デフォルト: 自分）",
+	"cmd.전적":              "戦績",
+	"cmd.전적.desc":         "プレイヤーの最近の試合を表示します",
+	"cmd.전적.게임":           "ゲーム",
+	"cmd.전적.게임.desc":      "ゲーム",
+	"cmd.전적.플레이어":         "プレイヤー",
+	"cmd.전적.플레이어.desc":    "プレイヤーID (例: Faker#KR1)",
+	"cmd.전적.경기수":          "試合数",
+	"cmd.전적.경기수.desc":     "表示する試合数（デフォルト: 10）",
+	"cmd.게임중":             "ゲーム中",
+	"cmd.게임중.desc":        "プレイヤーが今ゲーム中かどうかを表示します",
+	"cmd.게임중.게임":          "ゲーム",
+	"cmd.게임중.게임.desc":     "ゲーム",
+	"cmd.게임중.플레이어":        "プレイヤー",
+	"cmd.게임중.플레이어.desc":   "プレイヤーID (例: Faker#KR1)",
+	"cmd.통계":              "統計",
+	"cmd.통계.desc":         "プレイヤーの詳細な統計を表示します",
+	"cmd.통계.게임":           "ゲーム",
+	"cmd.통계.게임.desc":      "ゲーム",
+	"cmd.통계.플레이어":         "プレイヤー",
+	"cmd.통계.플레이어.desc":    "プレイヤーID (例: Faker#KR1)",
+
+	"cmd.알림경로":                 "通知ルート",
+	"cmd.알림경로.desc":            "通知を送るチャンネルとWebhookを管理します (ルートがなければ /チャンネル設定 のチャンネルへ送信)",
+	"cmd.알림경로.채널":              "チャンネル",
+	"cmd.알림경로.채널.desc":         "ボットのメッセージとしてチャンネルに通知を送ります",
+	"cmd.알림경로.채널.채널":           "チャンネル",
+	"cmd.알림경로.채널.채널.desc":      "通知を送るチャンネル",
+	"cmd.알림경로.채널.게임":           "ゲーム",
+	"cmd.알림경로.채널.게임.desc":      "このゲームの通知だけを送ります (既定: すべてのゲーム)",
+	"cmd.알림경로.디스코드웹훅":          "discord-webhook",
+	"cmd.알림경로.디스코드웹훅.desc":     "Discord Webhookに通知を送ります (ゲームごとに名前/アバターを指定可能)",
+	"cmd.알림경로.디스코드웹훅.url":      "url",
+	"cmd.알림경로.디스코드웹훅.url.desc": "Webhook URL (https://discord.com/api/webhooks/...)",
+	"cmd.알림경로.디스코드웹훅.게임":       "ゲーム",
+	"cmd.알림경로.디스코드웹훅.게임.desc":  "このゲームの通知だけを送ります (既定: すべてのゲーム)",
+	"cmd.알림경로.디스코드웹훅.이름":       "名前",
+	"cmd.알림경로.디스코드웹훅.이름.desc":  "Webhookの表示名 (既定: ゲーム名)",
+	"cmd.알림경로.디스코드웹훅.아바타":      "アバター",
+	"cmd.알림경로.디스코드웹훅.아바타.desc": "Webhookのアバター画像URL",
+	"cmd.알림경로.웹훅":              "webhook",
+	"cmd.알림경로.웹훅.desc":         "外部WebhookにJSONで通知を送ります",
+	"cmd.알림경로.웹훅.url":          "url",
+	"cmd.알림경로.웹훅.url.desc":     "Webhook URL (https://...)",
+	"cmd.알림경로.웹훅.게임":           "ゲーム",
+	"cmd.알림경로.웹훅.게임.desc":      "このゲームの通知だけを送ります (既定: すべてのゲーム)",
+	"cmd.알림경로.슬랙":              "slack",
+	"cmd.알림경로.슬랙.desc":         "Slackの受信Webhookに通知を送ります",
+	"cmd.알림경로.슬랙.url":          "url",
+	"cmd.알림경로.슬랙.url.desc":     "Slack Webhook URL (https://hooks.slack.com/services/...)",
+	"cmd.알림경로.슬랙.게임":           "ゲーム",
+	"cmd.알림경로.슬랙.게임.desc":      "このゲームの通知だけを送ります (既定: すべてのゲーム)",
+	"cmd.알림경로.텔레그램":            "telegram",
+	"cmd.알림경로.텔레그램.desc":       "Telegramのチャットに通知を送ります (先にボットをチャットに招待してください)",
+	"cmd.알림경로.텔레그램.채팅":         "チャット",
+	"cmd.알림경로.텔레그램.채팅.desc":    "チャットID (例: -1001234567890) または @チャンネル名",
+	"cmd.알림경로.텔레그램.게임":         "ゲーム",
+	"cmd.알림경로.텔레그램.게임.desc":    "このゲームの通知だけを送ります (既定: すべてのゲーム)",
+	"cmd.알림경로.목록":              "一覧",
+	"cmd.알림경로.목록.desc":         "このサーバーの通知ルート一覧",
+	"cmd.알림경로.삭제":              "削除",
+	"cmd.알림경로.삭제.desc":         "通知ルートを削除します",
+	"cmd.알림경로.삭제.번호":           "番号",
+	"cmd.알림경로.삭제.번호.desc":      "/通知ルート 一覧 のルート番号",
+
+	"cmd.리포트":            "レポート",
+	"cmd.리포트.desc":       "登録済みプレイヤーの週間/月間の活動まとめを表示します",
+	"cmd.리포트.기간":         "期間",
+	"cmd.리포트.기간.desc":    "まとめる期間 (既定: 週間)",
+	"cmd.리포트.기간.weekly":  "週間 (直近7日)",
+	"cmd.리포트.기간.monthly": "月間 (直近30日)",
+	"cmd.리포트설정":          "レポート設定",
+	"cmd.리포트설정.desc":     "定期レポートを送る曜日、時刻、タイムゾーンを設定します",
+	"cmd.리포트설정.요일":       "曜日",
+	"cmd.리포트설정.요일.desc":  "週間レポートの曜日",
+	"cmd.리포트설정.요일.0":     "日曜日",
+	"cmd.리포트설정.요일.1":     "月曜日",
+	"cmd.리포트설정.요일.2":     "火曜日",
+	"cmd.리포트설정.요일.3":     "水曜日",
+	"cmd.리포트설정.요일.4":     "木曜日",
+	"cmd.리포트설정.요일.5":     "金曜日",
+	"cmd.리포트설정.요일.6":     "土曜日",
+	"cmd.리포트설정.시":        "時",
+	"cmd.리포트설정.시.desc":   "送信する時刻 (0-23時)",
+	"cmd.리포트설정.분":        "分",
+	"cmd.리포트설정.분.desc":   "送信する時刻 (0-59分)",
+	"cmd.리포트설정.시간대":      "タイムゾーン",
+	"cmd.리포트설정.시간대.desc": "IANAタイムゾーン (例: Asia/Tokyo, America/Los_Angeles)",
+	"cmd.리포트설정.주간":       "週間",
+	"cmd.리포트설정.주간.desc":  "週間レポートを送る",
+	"cmd.리포트설정.월간":       "月間",
+	"cmd.리포트설정.월간.desc":  "月間レポートを送る (毎月1日)",
+
+	"cmd.랭킹":               "ランキング",
+	"cmd.랭킹.desc":          "このサーバーに登録されたプレイヤーの順位を表示します",
+	"cmd.랭킹.지표":            "指標",
+	"cmd.랭킹.지표.desc":       "順位の基準",
+	"cmd.랭킹.지표.rank":       "ランク",
+	"cmd.랭킹.지표.winrate":    "勝率",
+	"cmd.랭킹.지표.kda":        "平均KDA",
+	"cmd.랭킹.지표.level":      "メイプルストーリーのレベル",
+	"cmd.랭킹.지표.weekly_exp": "週間経験値",
+	"cmd.랭킹.경기수":           "試合数",
+	"cmd.랭킹.경기수.desc":      "勝率/KDAを計算する直近の試合数 (既定: 20)",
+	"cmd.랭킹.고정":            "固定",
+	"cmd.랭킹.고정.desc":       "このチャンネルに固定し、確認のたびに自動更新します (サーバー管理権限が必要)",
+
+	"cmd.캐릭터":          "キャラクター",
+	"cmd.캐릭터.desc":     "メイプルストーリーのキャラクター情報を表示します",
+	"cmd.캐릭터.캐릭터":      "キャラクター",
+	"cmd.캐릭터.캐릭터.desc": "キャラクター名",
+	"cmd.성장":           "成長",
+	"cmd.성장.desc":      "登録済みメイプルストーリーキャラクターのレベル/経験値の成長をグラフで表示します",
+	"cmd.성장.캐릭터":       "キャラクター",
+	"cmd.성장.캐릭터.desc":  "キャラクター名",
+	"cmd.성장.기간":        "期間",
+	"cmd.성장.기간.desc":   "表示期間 (既定: 週間)",
+	"cmd.성장.기간.7":      "週間 (7日)",
+	"cmd.성장.기간.30":     "月間 (30日)",
+}
@@ -0,0 +1,440 @@
+package i18n
+
+// ko is the Korean catalog, the default locale
+var ko = map[string]string{
+	// Games
+	"game.lol":             "리그 오브 레전드",
+	"game.lol.desc":        "리그 오브 레전드 소환사의 경기 결과 추적",
+	"game.tft":             "전략적 팀 전투",
+	"game.tft.desc":        "전략적 팀 전투(TFT) 경기 결과 및 랭크 LP 추적",
+	"game.valorant":        "발로란트",
+	"game.valorant.desc":   "발로란트 경기 결과 및 경쟁전 티어 추적",
+	"game.steam":           "스팀",
+	"game.steam.desc":      "스팀 업적 달성, 플레이 시간, 새 게임 추적 (공개 프로필 필요)",
+	"game.maplestory":      "메이플스토리",
+	"game.maplestory.desc": "메이플스토리 캐릭터 레벨/경험치/장비 추적",
+
+	// Common errors
+	"error.unknown_game":        "알 수 없는 게임: `%s`. `/게임목록` 명령어로 지원되는 게임을 확인하세요.",
+	"error.invalid_player":      "잘못된 플레이어 ID 형식",
+	"error.internal":            "명령을 처리하는 중 오류가 발생했습니다. 잠시 후 다시 시도해주세요. (참조 코드: `%s`)",
+	"error.forbidden":           "이 명령어를 사용할 권한이 없습니다.",
+	"error.cooldown":            "잠시 후 다시 시도해주세요. (%d초 남음)",
+	"error.guild_only":          "서버에서만 사용할 수 있는 명령어입니다.",
+	"error.player_not_found":    "플레이어를 찾을 수 없습니다",
+	"error.match_unavailable":   "경기 정보를 가져올 수 없습니다",
+	"error.matches_unavailable": "경기 목록을 가져올 수 없습니다",
+
+	// /등록
+	"register.not_found":           "플레이어 `%s`를 찾을 수 없습니다. ID를 확인하고 다시 시도해주세요.",
+	"register.already_registered":  "플레이어 `%s`는 이미 %s에 등록되어 있습니다.",
+	"register.failed":              "플레이어 등록에 실패했습니다. 다시 시도해주세요.",
+	"register.already_tracking":    "플레이어 `%s`는 이미 이 서버에서 %s 추적 중입니다.",
+	"register.subscription_failed": "플레이어는 저장되었으나 구독 생성에 실패했습니다.",
+	"register.success":             "`%s`를 %s 추적에 성공적으로 등록했습니다!",
+	"register.success_dm":          "`%s`를 %s 추적에 등록했습니다! 새 소식은 DM으로 보내드릴게요.",
+	"register.already_following":   "플레이어 `%s`는 이미 %s에서 팔로우하고 있습니다.",
+
+	// /해제
+	"unregister.not_registered": "플레이어 `%s`는 %s에 등록되어 있지 않습니다.",
+	"unregister.failed":         "플레이어 등록 해제에 실패했습니다. 다시 시도해주세요.",
+	"unregister.success":        "`%s`를 %s 추적에서 성공적으로 해제했습니다.",
+
+	// /목록
+	"list.failed":   "플레이어 목록을 가져오는 데 실패했습니다.",
+	"list.empty":    "이 서버에 등록된 플레이어가 없습니다.\n`/등록` 명령어로 추가하세요!\n`/게임목록` 명령어로 지원되는 게임을 확인하세요.",
+	"list.title":    "**등록된 플레이어:**",
+	"list.empty_dm": "팔로우 중인 플레이어가 없습니다.\n`/등록` 명령어로 추가하면 DM으로 알림을 받을 수 있어요!",
+	"list.title_dm": "**팔로우 중인 플레이어 (DM 알림):**",
+
+	// /채널설정
+	"setchannel.failed":  "알림 채널 설정에 실패했습니다. 다시 시도해주세요.",
+	"setchannel.success": "게임 알림이 <#%s> 채널로 전송됩니다",
+
+	// /게임목록
+	"games.none":           "현재 지원되는 게임이 없습니다.",
+	"games.enabled":        "**지원되는 게임:**",
+	"games.disabled":       "**비활성화된 게임:**",
+	"games.hint":           "`/등록 게임:<게임> 플레이어:<ID>` 명령어로 추적을 시작하세요!",
+	"games.default_desc":   "%s 상태 변경 추적",
+	"games.missing_config": "%s 미설정",
+	"games.init_failed":    "초기화 실패",
+
+	// /최근
+	"recent.not_registered": "플레이어 `%s`는 %s에 등록되어 있지 않습니다. `/등록` 명령어로 먼저 등록해주세요.",
+	"recent.no_data":        "`%s`의 최근 데이터가 아직 없습니다. 잠시 후 다시 시도해주세요.",
+	"recent.failed":         "`%s`의 최근 데이터를 가져오는데 실패했습니다.",
+
+	// /언어
+	"language.set":     "이 서버의 봇 언어를 **%s**(으)로 설정했습니다.",
+	"language.auto":    "이 서버의 봇 언어를 각 멤버의 디스코드 언어에 맞춥니다. 알림은 한국어로 전송됩니다.",
+	"language.current": "이 서버의 봇 언어: **%s**",
+	"language.failed":  "언어 설정에 실패했습니다. 다시 시도해주세요.",
+
+	// /방해금지
+	"quiet.set":              "🌙 방해금지 시간: **%02d:00 ~ %02d:00** (%s). 이 시간에는 DM 알림을 보내지 않습니다.",
+	"quiet.off":              "방해금지 시간을 해제했습니다.",
+	"quiet.same_hours":       "시작과 종료 시간이 같을 수 없습니다.",
+	"quiet.invalid_timezone": "알 수 없는 시간대입니다: `%s` (예: Asia/Seoul)",
+	"quiet.failed":           "방해금지 설정을 저장하지 못했습니다. 다시 시도해주세요.",
+	"quiet.set_guild":        "🌙 이 서버의 방해금지 시간: **%02d:00 ~ %02d:00** (%s). 이 시간의 알림은 %s.",
+	"quiet.off_guild":        "이 서버의 방해금지 시간을 해제했습니다.",
+	"quiet.mode.digest":      "끝난 뒤 하나의 요약으로 보내드립니다",
+	"quiet.mode.drop":        "보내지 않습니다",
+
+	// /알림묶음
+	"burst.set":    "1분 안에 알림이 %d건을 넘으면 나머지를 하나의 요약으로 묶습니다.",
+	"burst.off":    "알림을 묶지 않고 모두 따로 보냅니다.",
+	"burst.failed": "알림 묶음 설정을 저장하지 못했습니다. 다시 시도해주세요.",
+
+	// /내플레이어
+	"link.not_tracked":  "플레이어 `%s`는 %s에서 추적 중이 아닙니다. `/등록` 명령어로 먼저 등록해주세요.",
+	"link.taken":        "`%s`는 이미 다른 멤버와 연결된 플레이어입니다.",
+	"link.taken_verify": "`%s`는 이미 다른 멤버와 연결된 플레이어입니다. 본인 계정이라면 `/연동`으로 인증하세요.",
+	"link.success":      "🔗 `%s`(%s)를 내 계정으로 연결했습니다. 멘션 규칙이 있는 알림에서 호출됩니다.",
+	"link.not_linked":   "`%s`는 내 계정과 연결되어 있지 않습니다.",
+	"link.removed":      "`%s`와 내 계정의 연결을 해제했습니다.",
+	"link.failed":       "플레이어 연결을 저장하지 못했습니다. 다시 시도해주세요.",
+
+	// /연동
+	"verify.unsupported":  "%s는 계정 인증을 지원하지 않습니다.",
+	"verify.already":      "`%s`는 이미 내 계정으로 인증되었습니다.",
+	"verify.title":        "🔐 `%s` 계정 인증",
+	"verify.next":         "설정을 바꾼 뒤 %d분 안에 `/연동 확인`을 입력하세요.",
+	"verify.no_challenge": "진행 중인 인증이 없거나 만료되었습니다. `/연동 시작`으로 다시 시작하세요.",
+	"verify.mismatch":     "아직 확인되지 않았습니다. %s\n게임에 반영되기까지 잠시 걸릴 수 있어요.",
+	"verify.success":      "✅ `%s`(%s) 계정이 인증되어 내 계정으로 연결되었습니다! 이제 이 플레이어의 알림을 DM으로 받고, 서버에서 알림을 직접 끄고 켤 수 있습니다.",
+
+	// /프로필
+	"profile.title":       "%s님의 프로필",
+	"profile.empty":       "%s님과 연결된 게임 계정이 없습니다. `/내플레이어 연결`이나 `/연동`으로 계정을 연결하세요.",
+	"profile.failed":      "프로필을 불러오지 못했습니다. 다시 시도해주세요.",
+	"profile.ranked":      "**%s** %s · %d승 %d패 (승률 %.0f%%)",
+	"profile.unranked":    "**%s** 언랭크",
+	"profile.recent":      "최근 %d경기 %d승 %d패 · KDA %.2f",
+	"profile.unavailable": "정보를 불러오지 못했습니다",
+	"profile.no_details":  "표시할 정보가 없습니다",
+
+	// /전적, /게임중, /통계
+	"lookup.unsupported": "%s는 이 조회를 지원하지 않습니다.",
+	"lookup.failed":      "`%s`의 정보를 가져오지 못했습니다",
+	"lookup.no_data":     "`%s`의 표시할 정보가 없습니다.",
+	"lookup.not_playing": "`%s`님은 지금 게임 중이 아닙니다.",
+
+	// /멘션
+	"mention.added":                      "멘션 규칙이 추가되었습니다: %s",
+	"mention.deleted":                    "멘션 규칙이 삭제되었습니다: %s",
+	"mention.empty":                      "설정된 멘션 규칙이 없습니다. 알림은 아무도 호출하지 않습니다.",
+	"mention.title":                      "**멘션 규칙:**",
+	"mention.no_rule":                    "%d번 멘션 규칙이 없습니다. `/멘션 목록`으로 번호를 확인하세요.",
+	"mention.role_or_member":             "역할과 멤버 중 하나만 지정해주세요.",
+	"mention.failed":                     "멘션 규칙을 저장하지 못했습니다. 다시 시도해주세요.",
+	"mention.all_games":                  "모든 게임",
+	"mention.owner":                      "연결된 본인",
+	"mention.event.promoted":             "랭크 승급",
+	"mention.event.rank_changed":         "랭크 변동",
+	"mention.event.pentakill":            "펜타킬",
+	"mention.event.level_up":             "레벨 업",
+	"mention.event.achievement_unlocked": "업적 달성",
+	"mention.event.new_game":             "새 게임",
+	"mention.event.match_completed":      "모든 경기",
+
+	// /랭킹
+	"leaderboard.failed":            "랭킹을 불러오지 못했습니다. 다시 시도해주세요.",
+	"leaderboard.pin_forbidden":     "랭킹 고정은 서버 관리 권한이 있어야 사용할 수 있습니다.",
+	"leaderboard.post_failed":       "랭킹 메시지를 보내지 못했습니다. 봇의 채널 권한을 확인해주세요.",
+	"leaderboard.pin_failed":        "랭킹을 고정했지만 자동 갱신 설정에 실패했습니다.",
+	"leaderboard.pinned":            "랭킹을 고정했습니다. 확인 주기마다 자동으로 갱신되며, 메시지를 삭제하면 갱신이 중지됩니다.",
+	"leaderboard.pinned_footer":     "자동 갱신 · 메시지를 삭제하면 갱신이 중지됩니다",
+	"leaderboard.title":             "🏆 %s 랭킹",
+	"leaderboard.title_games":       " (최근 %d경기)",
+	"leaderboard.footer":            "%d / %d 페이지 · %d명",
+	"leaderboard.winrate_entry":     "%.0f%% (%d전 %d승)",
+	"leaderboard.kda_entry":         "%.2f (%d경기)",
+	"leaderboard.exp_entry":         "+%s EXP",
+	"leaderboard.empty_rank":        "랭크 정보가 있는 플레이어가 없습니다. 랭크는 다음 확인 주기에 갱신됩니다.",
+	"leaderboard.empty_games":       "기록된 경기가 %d판 이상인 플레이어가 없습니다. 경기는 등록 이후부터 기록됩니다.",
+	"leaderboard.empty_weekly_exp":  "이번 주 성장 기록이 있는 메이플스토리 캐릭터가 없습니다.",
+	"leaderboard.empty":             "표시할 플레이어가 없습니다.",
+	"leaderboard.metric.rank":       "랭크",
+	"leaderboard.metric.winrate":    "승률",
+	"leaderboard.metric.kda":        "평균 KDA",
+	"leaderboard.metric.level":      "메이플스토리 레벨",
+	"leaderboard.metric.weekly_exp": "주간 경험치",
+
+	// /리포트, /리포트설정
+	"report.failed":               "리포트를 만들지 못했습니다. 다시 시도해주세요.",
+	"report.settings_unavailable": "리포트 설정을 불러오지 못했습니다.",
+	"report.settings_failed":      "리포트 설정 저장에 실패했습니다. 다시 시도해주세요.",
+	"report.invalid_timezone":     "알 수 없는 시간대입니다: `%s` (예: Asia/Seoul)",
+	"report.weekly":               "주간 리포트: 매주 %s %s",
+	"report.weekly_off":           "주간 리포트: 꺼짐",
+	"report.monthly":              "월간 리포트: 매월 1일 %s",
+	"report.monthly_off":          "월간 리포트: 꺼짐",
+	"report.channel_hint":         "리포트는 `/채널설정`으로 지정한 채널로 전송됩니다.",
+	"report.title_weekly":         "📊 주간 리포트 (%s ~ %s)",
+	"report.title_monthly":        "📊 월간 리포트 (%s ~ %s)",
+	"report.footer":               "등록된 플레이어의 기록으로 집계됩니다",
+	"report.no_activity":          "이 기간에 기록된 활동이 없습니다.",
+	"report.mvp_weekly":           "이번 주의 플레이어",
+	"report.mvp_monthly":          "이번 달의 플레이어",
+	"report.more_players":         "외 %d명",
+	"report.idle_players":         "활동 없는 플레이어 %d명 제외",
+	"report.record_short":         "%d전 %d승 (%.0f%%)",
+	"report.levels_gained":        "레벨 +%.2f",
+	"report.record":               "%d전 %d승 %d패 (승률 %.0f%%)",
+	"report.best":                 "최고: %s",
+	"report.worst":                "최저: %s",
+	"report.most_played":          "모스트: %s (%d판)",
+	"report.win":                  "승",
+	"report.loss":                 "패",
+	"weekday.sunday":              "일요일",
+	"weekday.monday":              "월요일",
+	"weekday.tuesday":             "화요일",
+	"weekday.wednesday":           "수요일",
+	"weekday.thursday":            "목요일",
+	"weekday.friday":              "금요일",
+	"weekday.saturday":            "토요일",
+
+	// /알림경로
+	"route.added":                   "알림 경로가 추가되었습니다: %s",
+	"route.deleted":                 "알림 경로가 삭제되었습니다: %s",
+	"route.title":                   "**알림 경로:**",
+	"route.empty":                   "설정된 알림 경로가 없습니다. 알림은 `/채널설정`으로 지정한 채널로 전송됩니다.",
+	"route.fallback_hint":           "게임에 맞는 경로가 없으면 `/채널설정` 채널로 전송됩니다.",
+	"route.no_route":                "%d번 알림 경로가 없습니다. `/알림경로 목록`으로 번호를 확인하세요.",
+	"route.unavailable":             "알림 경로를 불러오지 못했습니다.",
+	"route.failed":                  "알림 경로 추가에 실패했습니다. 다시 시도해주세요.",
+	"route.delete_failed":           "알림 경로 삭제에 실패했습니다. 다시 시도해주세요.",
+	"route.invalid_discord_webhook": "디스코드 웹훅 URL이 올바르지 않습니다. `https://discord.com/api/webhooks/...` 형식이어야 합니다.",
+	"route.invalid_avatar":          "아바타 URL은 `https://`로 시작해야 합니다.",
+	"route.invalid_webhook":         "웹훅 URL은 `https://`로 시작해야 합니다.",
+	"route.private_webhook":         "웹훅 주소를 확인할 수 없거나 내부 네트워크를 가리킵니다. 공개된 주소를 사용해주세요.",
+	"route.invalid_slack":           "슬랙 웹훅 URL이 올바르지 않습니다. `https://hooks.slack.com/...` 형식이어야 합니다.",
+	"route.invalid_telegram":        "텔레그램 채팅 ID(숫자) 또는 `@채널이름`을 입력해주세요.",
+	"route.telegram_claim":          "텔레그램 채팅 `%s`에 알림을 보낼 권한을 확인합니다. 봇을 채팅에 초대한 뒤 아래 메시지를 채팅에 그대로 보내고, %d분 안에 이 명령어를 다시 실행해주세요.\n```\n%s\n```",
+	"route.telegram_not_posted":     "`%s` 메시지를 채팅 `%s`에서 찾지 못했습니다. 봇이 채팅에 있는지 확인하고 메시지를 보낸 뒤 다시 실행해주세요.",
+	"route.telegram_unavailable":    "텔레그램 채팅을 확인하지 못했습니다. 잠시 후 다시 시도해주세요.",
+	"route.all_games":               "모든 게임",
+	"route.username":                "(이름: %s)",
+	"route.sink.channel":            "채널",
+	"route.sink.discord_webhook":    "디스코드 웹훅",
+	"route.sink.webhook":            "JSON 웹훅",
+	"route.sink.slack":              "슬랙",
+	"route.sink.telegram":           "텔레그램",
+
+	// /캐릭터, /성장
+	"character.invalid_name": "잘못된 캐릭터 이름",
+	"character.not_found":    "캐릭터 `%s`를 찾을 수 없습니다.",
+	"character.failed":       "`%s`의 캐릭터 정보를 가져오는데 실패했습니다.",
+	"growth.not_registered":  "캐릭터 `%s`는 등록되어 있지 않습니다. `/등록` 명령어로 먼저 등록해주세요.",
+	"growth.no_period":       "조회할 수 있는 기간이 없습니다.",
+	"growth.failed":          "성장 기록을 가져오는데 실패했습니다.",
+	"growth.not_enough":      "`%s`의 성장 기록이 충분하지 않습니다. 잠시 후 다시 시도해주세요.",
+	"growth.chart_failed":    "성장 그래프를 생성하는데 실패했습니다.",
+	"growth.title_weekly":    "📈 주간 성장 기록",
+	"growth.title_monthly":   "📈 월간 성장 기록",
+	"growth.total":           "총 획득 경험치",
+	"growth.daily_average":   "일 평균",
+	"growth.daily":           "일별 획득 경험치",
+	"growth.span":            " (%d일)",
+
+	// Digests
+	"notify.digest_title": "🌙 방해금지 시간 동안의 알림 %d건",
+	"notify.burst_title":  "📦 알림 %d건 요약",
+	"notify.digest_more":  "…외 %d건",
+	"notify.view_details": "자세히 보기",
+	"notify.image":        "이미지",
+
+	// Notification buttons
+	"button.scoreboard":     "전체 스코어보드",
+	"button.recent":         "최근 %d경기",
+	"button.mute":           "알림 끄기",
+	"button.unmute":         "알림 다시 켜기",
+	"button.profile":        "프로필",
+	"button.invalid":        "유효하지 않은 버튼입니다.",
+	"button.untracked":      "더 이상 추적하지 않는 플레이어입니다.",
+	"button.game_disabled":  "현재 비활성화된 게임입니다.",
+	"button.no_details":     "이 게임은 경기 정보를 지원하지 않습니다.",
+	"button.details_failed": "경기 정보를 가져오지 못했습니다: %s",
+	"button.not_subscribed": "이 서버에 등록되지 않은 플레이어입니다.",
+	"button.mute_forbidden": "플레이어를 등록한 멤버, 인증된 본인, 서버 관리 권한이 있는 멤버만 알림을 변경할 수 있습니다.",
+	"button.mute_failed":    "알림 설정 변경 중 오류가 발생했습니다.",
+	"button.muted":          "🔕 **%s** 알림을 껐습니다.",
+	"button.unmuted":        "🔔 **%s** 알림을 다시 켰습니다.",
+	"button.previous":       "◀ 이전",
+	"button.next":           "다음 ▶",
+
+	// League of Legends
+	"lol.invalid_format":      "잘못된 형식: 소환사명#태그 형식이어야 합니다 (예: Faker#KR1)",
+	"lol.empty_name":          "소환사명과 태그는 비워둘 수 없습니다",
+	"lol.invalid_riot_id":     "잘못된 Riot ID 형식",
+	"lol.match_result":        "경기 결과",
+	"lol.player_not_in_match": "경기 데이터에서 플레이어를 찾을 수 없습니다",
+	"lol.victory":             "승리",
+	"lol.defeat":              "패배",
+	"lol.damage":              "피해량",
+	"lol.gold":                "골드",
+	"lol.vision":              "시야 점수",
+	"lol.duration":            "경기 시간",
+	"lol.match_id":            "경기 ID: %s",
+	"lol.solo_rank":           "솔로랭크",
+	"lol.pentakill":           "🔥 펜타킬!",
+	"lol.verify_icon":         "게임 클라이언트에서 소환사 아이콘을 **%d번 기본 아이콘**(오른쪽 그림)으로 바꿔주세요.",
+	"lol.scoreboard":          "전체 스코어보드",
+	"lol.blue_team":           "블루팀",
+	"lol.red_team":            "레드팀",
+	"lol.team_summary":        "%s (%s · %d킬)",
+	"lol.recent_title":        "최근 %d경기",
+	"lol.recent_none":         "최근 경기 기록이 없습니다.",
+	"lol.recent_summary":      "%d승 %d패 (승률 %.0f%%) · 평균 KDA %.2f",
+	"lol.win_short":           "✅ 승",
+	"lol.loss_short":          "❌ 패",
+	"lol.live_title":          "🔴 게임 중",
+	"lol.live_loading":        "로딩 중",
+	"lol.live_elapsed":        "%d분째 진행 중",
+	"lol.pentakill_count":     "🔥 펜타킬 x%d (%s)",
+	"lol.rank_changed":        "🏅 솔로 랭크 변동",
+
+	// TFT
+	"tft.ranked":       "랭크",
+	"tft.match_result": "TFT 경기 결과",
+	"tft.rank_changed": "🏅 TFT 랭크 변동",
+	"tft.placement":    "%d등",
+	"tft.level":        "레벨",
+	"tft.damage":       "플레이어 피해량",
+	"tft.traits":       "시너지",
+	"tft.units":        "유닛",
+	"tft.augments":     "증강",
+
+	// Valorant
+	"valorant.invalid_format":   "잘못된 형식: 이름#태그 형식이어야 합니다 (예: TenZ#0505)",
+	"valorant.empty_name":       "이름과 태그는 비워둘 수 없습니다",
+	"valorant.match_result":     "발로란트 경기 결과",
+	"valorant.draw":             "무승부",
+	"valorant.score":            "스코어",
+	"valorant.headshots":        "헤드샷",
+	"valorant.competitive_tier": "경쟁전 티어",
+
+	// Riot queues
+	"queue.400":    "일반 (드래프트)",
+	"queue.420":    "솔로 랭크",
+	"queue.430":    "일반 (블라인드)",
+	"queue.440":    "자유 랭크",
+	"queue.450":    "무작위 총력전",
+	"queue.900":    "우르프",
+	"queue.1020":   "단일 챔피언",
+	"queue.1300":   "돌격! 넥서스",
+	"queue.1400":   "궁극기 주문서",
+	"queue.1700":   "아레나",
+	"queue.custom": "사용자 설정 게임",
+
+	// Steam
+	"steam.playtime_jump":       "%s: +%.1f시간 (총 %d시간)",
+	"steam.invalid_profile_url": "잘못된 프로필 URL입니다",
+	"steam.invalid_player":      "SteamID64(예: 76561197960287930), 커스텀 URL 이름 또는 프로필 URL을 입력해주세요",
+	"steam.profile_unavailable": "프로필을 가져올 수 없습니다",
+	"steam.games_unavailable":   "게임 목록을 가져올 수 없습니다",
+	"steam.title_achievements":  "🏆 업적 %d개 달성",
+	"steam.title_new_games":     "🎮 새 게임 추가",
+	"steam.title_playtime":      "⏱️ 플레이 시간 증가",
+	"steam.title_profile":       "🎮 스팀 프로필",
+	"steam.new_games":           "🆕 새 게임",
+	"steam.playtime":            "⏱️ 플레이 시간",
+	"steam.playing":             "**%s** 플레이 중",
+	"steam.more_achievements":   "외 %d개",
+	"steam.rarity":              "희귀도 %s",
+	"steam.owned_games":         "보유 게임",
+	"steam.game_count":          "%d개",
+	"steam.total_playtime":      "총 플레이 시간",
+	"steam.hours":               "%d시간",
+	"steam.recently_played":     "최근 플레이",
+	"steam.recent_line":         "[%s](%s) — 2주간 %.1f시간 (총 %d시간)",
+
+	// Custom trackers
+	"custom.empty_player":   "플레이어 ID를 입력해주세요",
+	"custom.invalid_player": "플레이어 ID 형식이 올바르지 않습니다 (형식: `%s`)",
+
+	// MapleStory
+	"maple.empty_name":            "캐릭터 이름이 비어있습니다",
+	"maple.name_too_long":         "캐릭터 이름이 너무 깁니다 (최대 12자)",
+	"maple.character_not_found":   "캐릭터를 찾을 수 없습니다",
+	"maple.character_unavailable": "캐릭터 정보를 가져올 수 없습니다",
+	"maple.title_status":          "📊 메이플스토리 캐릭터 상태",
+	"maple.title_level_up":        "🎉 메이플스토리 레벨 업!",
+	"maple.title_gear":            "⚔️ 메이플스토리 장비 변경",
+	"maple.title_exp":             "📈 메이플스토리 경험치 획득",
+	"maple.level":                 "레벨",
+	"maple.exp":                   "경험치",
+	"maple.combat_power":          "전투력",
+	"maple.gear_changes":          "장비 변경",
+	"maple.exp_gained":            "획득 경험치",
+	"maple.next_level":            "다음 레벨까지",
+	"maple.eta":                   "약 %s",
+	"maple.levels_gained":         "(+%d레벨)",
+	"maple.profile":               "Lv.%d (%s%%) · %s · %s",
+	"maple.exp_summary":           "경험치 +%d",
+	"maple.gear_equipped":         "🆕 **%s** %s 장착",
+	"maple.gear_starforce_up":     "⭐ **%s** %d성 달성 (%d → %d)",
+	"maple.gear_starforce_down":   "💥 **%s** 스타포스 하락 (%d → %d)",
+	"maple.gear_unequipped":       "➖ **%s** %s 해제",
+	"maple.potential":             "잠재능력",
+	"maple.additional_potential":  "에디셔널",
+	"maple.potential_grade":       "✨ **%s** %s %s → %s: %s",
+	"maple.potential_reset":       "🔄 **%s** %s 재설정 (%s): %s",
+	"maple.no_grade":              "없음",
+	"maple.power_eok_man":         "%d억 %d만",
+	"maple.power_eok":             "%d억",
+	"maple.power_man":             "%d만",
+	"maple.snapshot_unavailable":  "%s 캐릭터 정보를 가져올 수 없습니다",
+	"maple.snapshot_empty":        "%s 캐릭터 데이터가 없습니다",
+	"maple.page.basic":            "기본 정보",
+	"maple.page.stat":             "스탯",
+	"maple.page.equipment":        "장비",
+	"maple.page.symbol":           "심볼",
+	"maple.page.ability":          "어빌리티 / 링크 스킬",
+	"maple.page.guild":            "길드",
+	"maple.page_footer":           "메이플스토리 · %d/%d 페이지",
+	"maple.world":                 "월드",
+	"maple.class":                 "직업",
+	"maple.class_value":           "%s (%s차)",
+	"maple.gender":                "성별",
+	"maple.created":               "생성일",
+	"maple.popularity":            "인기도",
+	"maple.union":                 "유니온",
+	"maple.stat_unavailable":      "스탯 정보를 가져올 수 없습니다",
+	"maple.hyper_stat":            "하이퍼 스탯 (프리셋 %s)",
+	"maple.equipment_unavailable": "장비 정보를 가져올 수 없습니다",
+	"maple.no_equipment":          "착용 중인 장비가 없습니다.",
+	"maple.symbol_unavailable":    "심볼 정보를 가져올 수 없습니다",
+	"maple.no_symbols":            "착용 중인 심볼이 없습니다.",
+	"maple.symbol_value":          "Lv.%d (포스 %s)",
+	"maple.ability_unavailable":   "어빌리티 정보를 가져올 수 없습니다",
+	"maple.ability":               "어빌리티 (%s)",
+	"maple.link_skills":           "링크 스킬",
+	"maple.no_ability":            "어빌리티 정보가 없습니다.",
+	"maple.guild_unavailable":     "길드 정보를 가져올 수 없습니다",
+	"maple.no_guild":              "가입한 길드가 없습니다.",
+	"maple.guild_name":            "길드명",
+	"maple.guild_master":          "길드 마스터",
+	"maple.guild_members":         "인원",
+	"maple.member_count":          "%d명",
+	"maple.guild_fame":            "명성치",
+	"maple.guild_points":          "포인트",
+
+	// Durations
+	"duration.days_hours":    "%d일 %d시간",
+	"duration.hours_minutes": "%d시간 %d분",
+	"duration.minutes":       "%d분",
+
+	// Nexon API errors
+	"nexon.bad_request":         "잘못된 요청입니다 (HTTP 400): %s",
+	"nexon.forbidden":           "권한이 없습니다 (HTTP 403)",
+	"nexon.rate_limited":        "API 호출량 초과 (HTTP 429)",
+	"nexon.server_error":        "서버 내부 오류 (HTTP 500)",
+	"nexon.maintenance":         "API 점검 중 (HTTP 503)",
+	"nexon.unknown":             "API 오류: HTTP %d, body: %s",
+	"nexon.character_not_found": "캐릭터를 찾을 수 없습니다: %s",
+	"nexon.guild_not_found":     "길드를 찾을 수 없습니다: %s",
+	"nexon.history_unavailable": "%s 이전의 데이터는 조회할 수 없습니다",
+}
@@ -0,0 +1,328 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// Metric is what players are ranked by
+type Metric string
+
+const (
+	MetricRank      Metric = "rank"       // ranked standing (LoL solo queue, TFT)
+	MetricWinRate   Metric = "winrate"    // win rate over the last N games
+	MetricKDA       Metric = "kda"        // average KDA over the last N games
+	MetricLevel     Metric = "level"      // MapleStory level
+	MetricWeeklyExp Metric = "weekly_exp" // MapleStory EXP gained this week
+)
+
+// Metrics lists every metric in display order
+var Metrics = []Metric{MetricRank, MetricWinRate, MetricKDA, MetricLevel, MetricWeeklyExp}
+
+// Label returns the name of the metric in a locale
+func (m Metric) Label(l i18n.Locale) string {
+	if label, ok := l.Lookup("leaderboard.metric." + string(m)); ok {
+		return label
+	}
+	return string(m)
+}
+
+// usesGames reports whether the metric is computed over the last N games
+func (m Metric) usesGames() bool {
+	return m == MetricWinRate || m == MetricKDA
+}
+
+const (
+	// DefaultGames is the default match window for per-game metrics
+	DefaultGames = 20
+
+	// minGames is the fewest recorded games needed to appear on a per-game board
+	minGames = 3
+
+	// PageSize is the number of entries on one page
+	PageSize = 10
+)
+
+// Entry is one player's line on the board
+type Entry struct {
+	Summoner *storage.Summoner
+	GameName string
+	Value    float64 // sort key, higher is better
+	Display  string
+}
+
+// Board is a guild's leaderboard for a metric
+type Board struct {
+	Metric  Metric
+	Games   int
+	Locale  i18n.Locale // language of the entries and the embed
+	Entries []Entry
+}
+
+// PageCount returns the number of pages (at least one)
+func (b *Board) PageCount() int {
+	return max((len(b.Entries)+PageSize-1)/PageSize, 1)
+}
+
+// Build ranks the players tracked in a guild from stored data only
+func Build(repo *storage.Repository, registry *game.Registry, l i18n.Locale, guildID string, metric Metric, games int, now time.Time) (*Board, error) {
+	if games <= 0 {
+		games = DefaultGames
+	}
+
+	summoners, err := repo.GetSummonersByGuild(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	board := &Board{Metric: metric, Games: games, Locale: l}
+	for _, summoner := range summoners {
+		tracker, err := registry.Get(game.GameType(summoner.GameType))
+		if err != nil {
+			continue
+		}
+
+		entry, ok, err := buildEntry(repo, tracker, l, summoner, metric, games, now)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", summoner.RiotID, err)
+		}
+		if ok {
+			entry.Summoner = summoner
+			entry.GameName = tracker.Name()
+			board.Entries = append(board.Entries, entry)
+		}
+	}
+
+	sort.SliceStable(board.Entries, func(i, j int) bool {
+		return board.Entries[i].Value > board.Entries[j].Value
+	})
+	return board, nil
+}
+
+// buildEntry computes one player's entry; ok is false if the metric does not apply
+func buildEntry(repo *storage.Repository, tracker game.Tracker, l i18n.Locale, summoner *storage.Summoner, metric Metric, games int, now time.Time) (entry Entry, ok bool, err error) {
+	switch metric {
+	case MetricRank:
+		reader, isRanked := tracker.(game.RankReader)
+		if !isRanked {
+			return entry, false, nil
+		}
+		state, err := loadState(repo, summoner)
+		if err != nil || state == nil {
+			return entry, false, err
+		}
+		standing, err := reader.StateStanding(state)
+		if err != nil || standing == nil {
+			return entry, false, nil
+		}
+		return Entry{Value: float64(standing.Score()), Display: standing.String()}, true, nil
+
+	case MetricWinRate, MetricKDA:
+		matches, err := repo.GetRecentMatchResults(summoner.ID, games)
+		if err != nil {
+			return entry, false, err
+		}
+		if len(matches) < minGames {
+			return entry, false, nil
+		}
+		wins, kda := 0, 0.0
+		for _, m := range matches {
+			if m.Win {
+				wins++
+			}
+			kda += m.KDA()
+		}
+		n := float64(len(matches))
+		if metric == MetricWinRate {
+			rate := float64(wins) / n
+			return Entry{Value: rate, Display: l.T("leaderboard.winrate_entry", rate*100, len(matches), wins)}, true, nil
+		}
+		avg := kda / n
+		return Entry{Value: avg, Display: l.T("leaderboard.kda_entry", avg, len(matches))}, true, nil
+
+	case MetricLevel:
+		reader, isLeveled := tracker.(game.LevelReader)
+		if !isLeveled {
+			return entry, false, nil
+		}
+		state, err := loadState(repo, summoner)
+		if err != nil || state == nil {
+			return entry, false, err
+		}
+		level, exp, err := reader.StateLevel(state)
+		if err != nil || level == 0 {
+			return entry, false, nil
+		}
+		// EXP only breaks ties within a level
+		value := float64(level) + float64(exp)/1e18
+		return Entry{Value: value, Display: fmt.Sprintf("Lv.%d", level)}, true, nil
+
+	case MetricWeeklyExp:
+		if summoner.GameType != string(game.GameTypeMaplestory) {
+			return entry, false, nil
+		}
+		gained, ok, err := weeklyExp(repo, summoner, now)
+		if err != nil || !ok {
+			return entry, false, err
+		}
+		return Entry{Value: float64(gained), Display: l.T("leaderboard.exp_entry", formatExp(gained))}, true, nil
+	}
+
+	return entry, false, fmt.Errorf("unknown metric %q", metric)
+}
+
+// loadState returns a summoner's stored tracker state
+func loadState(repo *storage.Repository, summoner *storage.Summoner) (*game.State, error) {
+	stored, err := repo.GetPlayerState(summoner)
+	if err != nil || stored == nil {
+		return nil, err
+	}
+	return &game.State{Version: stored.Version, Data: json.RawMessage(stored.Data)}, nil
+}
+
+// weeklyExp returns the EXP gained since the start of the week (Monday, KST)
+// summed between daily snapshots
+func weeklyExp(repo *storage.Repository, summoner *storage.Summoner, now time.Time) (int64, bool, error) {
+	today := now.In(nexon.KST)
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	base := today.AddDate(0, 0, -daysSinceMonday-1)
+
+	snaps, err := repo.GetCharacterSnapshots(summoner.ID, nexon.FormatDate(base), nexon.FormatDate(today))
+	if err != nil {
+		return 0, false, err
+	}
+	if len(snaps) < 2 {
+		return 0, false, nil
+	}
+
+	var gained int64
+	for i := 1; i < len(snaps); i++ {
+		gained += expBetween(snaps[i-1], snaps[i])
+	}
+	return gained, true, nil
+}
+
+// expBetween returns the EXP gained between two snapshots. Across a level-up
+// it adds the rest of the old level, every level skipped and the EXP into the
+// new level
+func expBetween(prev, cur *storage.CharacterSnapshot) int64 {
+	switch {
+	case cur.Level < prev.Level:
+		return 0
+	case cur.Level == prev.Level:
+		return max(cur.Exp-prev.Exp, 0)
+	}
+
+	prevReq, curReq := levelRequirement(prev), levelRequirement(cur)
+	if prevReq == 0 {
+		prevReq = curReq
+	}
+	if curReq == 0 {
+		curReq = prevReq
+	}
+	if prevReq == 0 {
+		return 0
+	}
+
+	gained := max(prevReq-prev.Exp, 0) + cur.Exp
+	// Snapshots only know the requirement of their own level, so the levels
+	// in between are interpolated geometrically
+	levels := cur.Level - prev.Level
+	for step := int64(1); step < levels; step++ {
+		ratio := float64(curReq) / float64(prevReq)
+		gained += int64(float64(prevReq) * math.Pow(ratio, float64(step)/float64(levels)))
+	}
+	return gained
+}
+
+// levelRequirement returns the EXP needed to clear a snapshot's level, or 0 if
+// the snapshot has no EXP into the level to derive it from
+func levelRequirement(s *storage.CharacterSnapshot) int64 {
+	if s.ExpRate <= 0 || s.Exp <= 0 {
+		return 0
+	}
+	return int64(float64(s.Exp) / (s.ExpRate / 100))
+}
+
+// formatExp formats an EXP amount with thousands separators
+func formatExp(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	var sb strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(d)
+	}
+	return sb.String()
+}
+
+// Embed renders one page of the board
+func (b *Board) Embed(page int) *discordgo.MessageEmbed {
+	page = min(max(page, 0), b.PageCount()-1)
+
+	l := b.Locale
+	title := l.T("leaderboard.title", b.Metric.Label(l))
+	if b.Metric.usesGames() {
+		title += l.T("leaderboard.title_games", b.Games)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: title,
+		Color: 0xF1C40F,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("leaderboard.footer", page+1, b.PageCount(), len(b.Entries)),
+		},
+	}
+
+	if len(b.Entries) == 0 {
+		embed.Description = emptyMessage(l, b.Metric)
+		return embed
+	}
+
+	var sb strings.Builder
+	start := page * PageSize
+	for idx := start; idx < min(start+PageSize, len(b.Entries)); idx++ {
+		e := b.Entries[idx]
+		sb.WriteString(fmt.Sprintf("%s **%s** (%s) · %s\n", medal(idx), e.Summoner.RiotID, e.GameName, e.Display))
+	}
+	embed.Description = sb.String()
+	return embed
+}
+
+// medal returns the rank marker for a zero-based position
+func medal(idx int) string {
+	switch idx {
+	case 0:
+		return "🥇"
+	case 1:
+		return "🥈"
+	case 2:
+		return "🥉"
+	}
+	return fmt.Sprintf("`%2d.`", idx+1)
+}
+
+// emptyMessage explains why a board has no entries
+func emptyMessage(l i18n.Locale, metric Metric) string {
+	switch metric {
+	case MetricRank:
+		return l.T("leaderboard.empty_rank")
+	case MetricWinRate, MetricKDA:
+		return l.T("leaderboard.empty_games", minGames)
+	case MetricWeeklyExp:
+		return l.T("leaderboard.empty_weekly_exp")
+	}
+	return l.T("leaderboard.empty")
+}
@@ -0,0 +1,207 @@
+package leaderboard
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/games/lol"
+	"github.com/flor3z/discord-bot/internal/games/maplestory"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+const testGuild = "guild-1"
+
+// testBoard is a guild's players over a temporary database
+type testBoard struct {
+	repo     *storage.Repository
+	registry *game.Registry
+}
+
+func newTestBoard(t *testing.T) *testBoard {
+	t.Helper()
+	repo, err := storage.NewRepository(filepath.Join(t.TempDir(), "bot.db"))
+	if err != nil {
+		t.Fatalf("NewRepository: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+
+	registry := game.NewRegistry()
+	registry.Register(lol.NewTracker("test-key"))
+	registry.Register(maplestory.NewTracker("test-key", maplestory.Options{}))
+	return &testBoard{repo: repo, registry: registry}
+}
+
+// player stores a summoner followed by the test guild
+func (tb *testBoard) player(t *testing.T, gameType game.GameType, name string) *storage.Summoner {
+	t.Helper()
+	summoner := &storage.Summoner{PUUID: name, RiotID: name, GameType: string(gameType), Region: "KR"}
+	if err := tb.repo.CreateSummoner(summoner); err != nil {
+		t.Fatalf("CreateSummoner: %v", err)
+	}
+	if err := tb.repo.CreateSubscription(&storage.Subscription{SummonerID: summoner.ID, TargetType: storage.TargetGuild, TargetID: testGuild}); err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	return summoner
+}
+
+// build builds the test guild's board and returns its players in order
+func (tb *testBoard) build(t *testing.T, metric Metric, now time.Time) ([]string, *Board) {
+	t.Helper()
+	board, err := Build(tb.repo, tb.registry, i18n.Korean, testGuild, metric, 0, now)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	var names []string
+	for _, e := range board.Entries {
+		names = append(names, e.Summoner.RiotID)
+	}
+	return names, board
+}
+
+func TestBuildRank(t *testing.T) {
+	tb := newTestBoard(t)
+	ranks := map[string]string{
+		"gold":        `{"tier":"GOLD","rank":"II","lp":45}`,
+		"master":      `{"tier":"MASTER","rank":"I","lp":600}`,
+		"grandmaster": `{"tier":"GRANDMASTER","rank":"I","lp":0}`,
+	}
+	for _, name := range []string{"gold", "master", "grandmaster", "unranked"} {
+		summoner := tb.player(t, game.GameTypeLoL, name)
+		data := `{"match_id":"KR_1"}`
+		if rank, ok := ranks[name]; ok {
+			data = fmt.Sprintf(`{"match_id":"KR_1","rank":%s}`, rank)
+		}
+		if err := tb.repo.UpsertPlayerState(&storage.PlayerState{SummonerID: summoner.ID, Version: 2, Data: data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tb.player(t, game.GameTypeMaplestory, "maple")
+
+	got, board := tb.build(t, MetricRank, time.Now())
+	if want := []string{"grandmaster", "master", "gold"}; !slices.Equal(got, want) {
+		t.Errorf("rank board = %v, want %v", got, want)
+	}
+	if board.Entries[2].Display != "GOLD II 45LP" {
+		t.Errorf("display = %q", board.Entries[2].Display)
+	}
+}
+
+func TestBuildWinRate(t *testing.T) {
+	tb := newTestBoard(t)
+	record := func(name string, wins ...bool) {
+		summoner := tb.player(t, game.GameTypeLoL, name)
+		for i, win := range wins {
+			m := &storage.MatchResult{SummonerID: summoner.ID, MatchID: fmt.Sprintf("KR_%d", i), Win: win, Kills: 5, Deaths: 1, PlayedAt: time.Now()}
+			if err := tb.repo.InsertMatchResult(m); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	record("steady", true, false, true, false)
+	record("hot", true, true, true)
+	record("new", true, true) // fewer than minGames
+
+	got, _ := tb.build(t, MetricWinRate, time.Now())
+	if want := []string{"hot", "steady"}; !slices.Equal(got, want) {
+		t.Errorf("win rate board = %v, want %v", got, want)
+	}
+}
+
+func TestBuildWeeklyExp(t *testing.T) {
+	tb := newTestBoard(t)
+	// Wednesday; the week's baseline is Sunday's snapshot
+	now := time.Date(2026, 10, 21, 12, 0, 0, 0, time.UTC)
+
+	snapshots := map[string][]storage.CharacterSnapshot{
+		"grinder": {
+			{Date: "2026-10-17", Level: 250, Exp: 0, ExpRate: 0}, // last week
+			{Date: "2026-10-18", Level: 250, Exp: 1000, ExpRate: 10},
+			{Date: "2026-10-21", Level: 250, Exp: 9000, ExpRate: 90},
+		},
+		"leveler": {
+			{Date: "2026-10-18", Level: 260, Exp: 9000, ExpRate: 90},
+			{Date: "2026-10-20", Level: 261, Exp: 500, ExpRate: 5},
+		},
+		"idle": {
+			{Date: "2026-10-18", Level: 200, Exp: 500, ExpRate: 50},
+			{Date: "2026-10-21", Level: 200, Exp: 500, ExpRate: 50},
+		},
+		"new": {
+			{Date: "2026-10-21", Level: 100, Exp: 500, ExpRate: 50},
+		},
+	}
+	for _, name := range []string{"grinder", "leveler", "idle", "new"} {
+		summoner := tb.player(t, game.GameTypeMaplestory, name)
+		for _, snap := range snapshots[name] {
+			snap.SummonerID = summoner.ID
+			if err := tb.repo.UpsertCharacterSnapshot(&snap); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// The level-up counts the 1,000 EXP left of level 260 and the 500 into 261
+	got, board := tb.build(t, MetricWeeklyExp, now)
+	if want := []string{"grinder", "leveler", "idle"}; !slices.Equal(got, want) {
+		t.Errorf("weekly EXP board = %v, want %v", got, want)
+	}
+	if want := i18n.Korean.T("leaderboard.exp_entry", "8,000"); board.Entries[0].Display != want {
+		t.Errorf("display = %q, want %q", board.Entries[0].Display, want)
+	}
+	if board.Entries[1].Value != 1500 {
+		t.Errorf("leveler gained %v EXP, want 1500", board.Entries[1].Value)
+	}
+}
+
+func TestExpBetween(t *testing.T) {
+	snap := func(level, exp int64, rate float64) *storage.CharacterSnapshot {
+		return &storage.CharacterSnapshot{Level: level, Exp: exp, ExpRate: rate}
+	}
+
+	tests := []struct {
+		name      string
+		prev, cur *storage.CharacterSnapshot
+		want      int64
+	}{
+		{"same level", snap(250, 1000, 10), snap(250, 4000, 40), 3000},
+		{"no progress", snap(250, 1000, 10), snap(250, 1000, 10), 0},
+		{"level down", snap(251, 1000, 10), snap(250, 9000, 90), 0},
+		// 8,000 left of a 10,000 level plus 500 into a 20,000 level
+		{"one level-up", snap(250, 2000, 20), snap(251, 500, 2.5), 8500},
+		// the skipped level needs sqrt(10,000 * 40,000) = 20,000
+		{"skipped level", snap(250, 2000, 20), snap(252, 4000, 10), 8000 + 20000 + 4000},
+		{"new level empty", snap(250, 2000, 20), snap(251, 0, 0), 8000},
+		{"no requirement known", snap(250, 0, 0), snap(251, 0, 0), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expBetween(tt.prev, tt.cur); got != tt.want {
+				t.Errorf("expBetween = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatExp(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{1234567890123, "1,234,567,890,123"},
+	}
+
+	for _, tt := range tests {
+		if got := formatExp(tt.n); got != tt.want {
+			t.Errorf("formatExp(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
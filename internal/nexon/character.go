@@ -0,0 +1,216 @@
+package nexon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CharacterStat represents the response from /maplestory/v1/character/stat
+type CharacterStat struct {
+	Date           string      `json:"date"`
+	CharacterClass string      `json:"character_class"`
+	FinalStat      []FinalStat `json:"final_stat"`
+	RemainAP       int         `json:"remain_ap"`
+}
+
+// FinalStat is a single computed stat of a character
+type FinalStat struct {
+	StatName  string `json:"stat_name"`
+	StatValue string `json:"stat_value"`
+}
+
+// Find returns the value of the stat with the given name
+func (s *CharacterStat) Find(name string) (string, bool) {
+	for _, stat := range s.FinalStat {
+		if stat.StatName == name {
+			return stat.StatValue, true
+		}
+	}
+	return "", false
+}
+
+// CharacterPopularity represents the response from /maplestory/v1/character/popularity
+type CharacterPopularity struct {
+	Date       string `json:"date"`
+	Popularity int64  `json:"popularity"`
+}
+
+// CharacterItemEquipment represents the response from /maplestory/v1/character/item-equipment
+type CharacterItemEquipment struct {
+	Date            string          `json:"date"`
+	CharacterGender string          `json:"character_gender"`
+	CharacterClass  string          `json:"character_class"`
+	PresetNo        int             `json:"preset_no"`
+	ItemEquipment   []ItemEquipment `json:"item_equipment"`
+}
+
+// ItemEquipment is a single equipped item
+type ItemEquipment struct {
+	ItemEquipmentPart              string `json:"item_equipment_part"`
+	ItemEquipmentSlot              string `json:"item_equipment_slot"`
+	ItemName                       string `json:"item_name"`
+	ItemIcon                       string `json:"item_icon"`
+	PotentialOptionGrade           string `json:"potential_option_grade"`
+	AdditionalPotentialOptionGrade string `json:"additional_potential_option_grade"`
+	PotentialOption1               string `json:"potential_option_1"`
+	PotentialOption2               string `json:"potential_option_2"`
+	PotentialOption3               string `json:"potential_option_3"`
+	AdditionalPotentialOption1     string `json:"additional_potential_option_1"`
+	AdditionalPotentialOption2     string `json:"additional_potential_option_2"`
+	AdditionalPotentialOption3     string `json:"additional_potential_option_3"`
+	Starforce                      string `json:"starforce"`
+	ScrollUpgrade                  string `json:"scroll_upgrade"`
+}
+
+// CharacterSymbolEquipment represents the response from /maplestory/v1/character/symbol-equipment
+type CharacterSymbolEquipment struct {
+	Date           string   `json:"date"`
+	CharacterClass string   `json:"character_class"`
+	Symbol         []Symbol `json:"symbol"`
+}
+
+// Symbol is a single equipped Arcane or Authentic symbol
+type Symbol struct {
+	SymbolName               string `json:"symbol_name"`
+	SymbolIcon               string `json:"symbol_icon"`
+	SymbolForce              string `json:"symbol_force"`
+	SymbolLevel              int    `json:"symbol_level"`
+	SymbolGrowthCount        int    `json:"symbol_growth_count"`
+	SymbolRequireGrowthCount int    `json:"symbol_require_growth_count"`
+}
+
+// CharacterHyperStat represents the response from /maplestory/v1/character/hyper-stat
+type CharacterHyperStat struct {
+	Date                   string      `json:"date"`
+	CharacterClass         string      `json:"character_class"`
+	UsePresetNo            string      `json:"use_preset_no"`
+	UseAvailableHyperStat  int         `json:"use_available_hyper_stat"`
+	HyperStatPreset1       []HyperStat `json:"hyper_stat_preset_1"`
+	HyperStatPreset1Remain int         `json:"hyper_stat_preset_1_remain_point"`
+	HyperStatPreset2       []HyperStat `json:"hyper_stat_preset_2"`
+	HyperStatPreset2Remain int         `json:"hyper_stat_preset_2_remain_point"`
+	HyperStatPreset3       []HyperStat `json:"hyper_stat_preset_3"`
+	HyperStatPreset3Remain int         `json:"hyper_stat_preset_3_remain_point"`
+}
+
+// HyperStat is a single hyper stat allocation
+type HyperStat struct {
+	StatType     string `json:"stat_type"`
+	StatPoint    int    `json:"stat_point"`
+	StatLevel    int    `json:"stat_level"`
+	StatIncrease string `json:"stat_increase"`
+}
+
+// ActivePreset returns the hyper stats of the preset currently in use
+func (h *CharacterHyperStat) ActivePreset() []HyperStat {
+	switch h.UsePresetNo {
+	case "2":
+		return h.HyperStatPreset2
+	case "3":
+		return h.HyperStatPreset3
+	default:
+		return h.HyperStatPreset1
+	}
+}
+
+// CharacterAbility represents the response from /maplestory/v1/character/ability
+type CharacterAbility struct {
+	Date         string        `json:"date"`
+	AbilityGrade string        `json:"ability_grade"`
+	AbilityInfo  []AbilityInfo `json:"ability_info"`
+	RemainFame   int64         `json:"remain_fame"`
+	PresetNo     int           `json:"preset_no"`
+}
+
+// AbilityInfo is a single ability line
+type AbilityInfo struct {
+	AbilityNo    string `json:"ability_no"`
+	AbilityGrade string `json:"ability_grade"`
+	AbilityValue string `json:"ability_value"`
+}
+
+// CharacterLinkSkill represents the response from /maplestory/v1/character/link-skill
+type CharacterLinkSkill struct {
+	Date                    string      `json:"date"`
+	CharacterClass          string      `json:"character_class"`
+	CharacterLinkSkill      []LinkSkill `json:"character_link_skill"`
+	CharacterOwnedLinkSkill *LinkSkill  `json:"character_owned_link_skill"`
+}
+
+// LinkSkill is a single link skill
+type LinkSkill struct {
+	SkillName        string `json:"skill_name"`
+	SkillDescription string `json:"skill_description"`
+	SkillLevel       int    `json:"skill_level"`
+	SkillEffect      string `json:"skill_effect"`
+	SkillIcon        string `json:"skill_icon"`
+}
+
+// GetCharacterStat fetches the computed stats of a character
+func (c *Client) GetCharacterStat(ctx context.Context, ocid string) (*CharacterStat, error) {
+	var result CharacterStat
+	if err := c.get(ctx, characterEndpoint("stat", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterPopularity fetches the popularity (fame) of a character
+func (c *Client) GetCharacterPopularity(ctx context.Context, ocid string) (*CharacterPopularity, error) {
+	var result CharacterPopularity
+	if err := c.get(ctx, characterEndpoint("popularity", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterItemEquipment fetches the equipped items of a character
+func (c *Client) GetCharacterItemEquipment(ctx context.Context, ocid string) (*CharacterItemEquipment, error) {
+	var result CharacterItemEquipment
+	if err := c.get(ctx, characterEndpoint("item-equipment", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterSymbolEquipment fetches the equipped symbols of a character
+func (c *Client) GetCharacterSymbolEquipment(ctx context.Context, ocid string) (*CharacterSymbolEquipment, error) {
+	var result CharacterSymbolEquipment
+	if err := c.get(ctx, characterEndpoint("symbol-equipment", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterHyperStat fetches the hyper stat presets of a character
+func (c *Client) GetCharacterHyperStat(ctx context.Context, ocid string) (*CharacterHyperStat, error) {
+	var result CharacterHyperStat
+	if err := c.get(ctx, characterEndpoint("hyper-stat", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterAbility fetches the ability lines of a character
+func (c *Client) GetCharacterAbility(ctx context.Context, ocid string) (*CharacterAbility, error) {
+	var result CharacterAbility
+	if err := c.get(ctx, characterEndpoint("ability", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCharacterLinkSkill fetches the equipped link skills of a character
+func (c *Client) GetCharacterLinkSkill(ctx context.Context, ocid string) (*CharacterLinkSkill, error) {
+	var result CharacterLinkSkill
+	if err := c.get(ctx, characterEndpoint("link-skill", ocid), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// characterEndpoint builds the URL of a /maplestory/v1/character/* endpoint
+func characterEndpoint(resource, ocid string) string {
+	return fmt.Sprintf("%s/maplestory/v1/character/%s?ocid=%s", BaseURL, resource, url.QueryEscape(ocid))
+}
@@ -1,12 +1,16 @@
 package nexon
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
+
+	"github.com/flor3z/discord-bot/internal/i18n"
 )
 
 const (
@@ -75,17 +79,21 @@ func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
 }
 
 // get performs a GET request and decodes the JSON response
-func (c *Client) get(url string, result interface{}) error {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+// Requests are bound to ctx and logged with its correlation ID
+func (c *Client) get(ctx context.Context, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	start := time.Now()
 	resp, err := c.doRequest(req)
 	if err != nil {
+		slog.DebugContext(ctx, "Nexon API request failed", "path", req.URL.Path, "error", err)
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	slog.DebugContext(ctx, "Nexon API request", "path", req.URL.Path, "status", resp.StatusCode, "duration", time.Since(start))
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
@@ -106,19 +114,19 @@ func (c *Client) parseError(statusCode int, body []byte) error {
 		return fmt.Errorf("%s: %s (HTTP %d)", apiErr.Error.Name, apiErr.Error.Message, statusCode)
 	}
 
-	// Fallback error messages for known error codes
+	// Fallback error messages for known error codes, rendered in the reader's locale
 	switch statusCode {
 	case 400:
-		return fmt.Errorf("잘못된 요청입니다 (HTTP 400): %s", string(body))
+		return i18n.Errorf("nexon.bad_request", string(body))
 	case 403:
-		return fmt.Errorf("권한이 없습니다 (HTTP 403)")
+		return i18n.Errorf("nexon.forbidden")
 	case 429:
-		return fmt.Errorf("API 호출량 초과 (HTTP 429)")
+		return i18n.Errorf("nexon.rate_limited")
 	case 500:
-		return fmt.Errorf("서버 내부 오류 (HTTP 500)")
+		return i18n.Errorf("nexon.server_error")
 	case 503:
-		return fmt.Errorf("API 점검 중 (HTTP 503)")
+		return i18n.Errorf("nexon.maintenance")
 	default:
-		return fmt.Errorf("API 오류: HTTP %d, body: %s", statusCode, string(body))
+		return i18n.Errorf("nexon.unknown", statusCode, string(body))
 	}
 }
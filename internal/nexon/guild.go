@@ -0,0 +1,73 @@
+package nexon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// GuildID represents the response from /maplestory/v1/guild/id
+type GuildID struct {
+	OGuildID string `json:"oguild_id"`
+}
+
+// GuildBasic represents the response from /maplestory/v1/guild/basic
+type GuildBasic struct {
+	Date             string   `json:"date"`
+	WorldName        string   `json:"world_name"`
+	GuildName        string   `json:"guild_name"`
+	GuildLevel       int      `json:"guild_level"`
+	GuildFame        int64    `json:"guild_fame"`
+	GuildPoint       int64    `json:"guild_point"`
+	GuildMasterName  string   `json:"guild_master_name"`
+	GuildMemberCount int      `json:"guild_member_count"`
+	GuildMember      []string `json:"guild_member"`
+	GuildMark        string   `json:"guild_mark"`
+	GuildMarkCustom  string   `json:"guild_mark_custom"`
+}
+
+// GetGuildID fetches the guild identifier for a guild name within a world
+func (c *Client) GetGuildID(ctx context.Context, guildName, worldName string) (*GuildID, error) {
+	endpoint := fmt.Sprintf("%s/maplestory/v1/guild/id?guild_name=%s&world_name=%s",
+		BaseURL, url.QueryEscape(guildName), url.QueryEscape(worldName))
+
+	var result GuildID
+	if err := c.get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	if result.OGuildID == "" {
+		return nil, i18n.Errorf("nexon.guild_not_found", guildName)
+	}
+
+	return &result, nil
+}
+
+// GetGuildBasic fetches basic guild information by guild identifier
+func (c *Client) GetGuildBasic(ctx context.Context, oguildID string) (*GuildBasic, error) {
+	endpoint := fmt.Sprintf("%s/maplestory/v1/guild/basic?oguild_id=%s", BaseURL, url.QueryEscape(oguildID))
+
+	var result GuildBasic
+	if err := c.get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCharacterGuild resolves and fetches the guild a character belongs to
+// Returns nil without error if the character has no guild
+func (c *Client) GetCharacterGuild(ctx context.Context, basic *CharacterBasic) (*GuildBasic, error) {
+	if basic.CharacterGuildName == "" {
+		return nil, nil
+	}
+
+	guildID, err := c.GetGuildID(ctx, basic.CharacterGuildName, basic.WorldName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetGuildBasic(ctx, guildID.OGuildID)
+}
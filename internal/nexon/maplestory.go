@@ -4,8 +4,22 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
+
+	"github.com/flor3z/discord-bot/internal/i18n"
 )
 
+// KST is the timezone Nexon uses for the date query parameter
+var KST = time.FixedZone("KST", 9*60*60)
+
+// FirstHistoryDate is the earliest date the API can return historical data for
+var FirstHistoryDate = time.Date(2023, 12, 21, 0, 0, 0, 0, KST)
+
+// FormatDate formats a time as the API's date query parameter (YYYY-MM-DD in KST)
+func FormatDate(t time.Time) string {
+	return t.In(KST).Format("2006-01-02")
+}
+
 // CharacterOCID represents the response from /maplestory/v1/id
 type CharacterOCID struct {
 	OCID string `json:"ocid"`
@@ -13,10 +27,20 @@ type CharacterOCID struct {
 
 // CharacterBasic represents the response from /maplestory/v1/character/basic
 type CharacterBasic struct {
-	CharacterName    string `json:"character_name"`
-	CharacterLevel   int64  `json:"character_level"`
-	CharacterExp     int64  `json:"character_exp"`
-	CharacterExpRate string `json:"character_exp_rate"`
+	Date                     string `json:"date"`
+	CharacterName            string `json:"character_name"`
+	WorldName                string `json:"world_name"`
+	CharacterGender          string `json:"character_gender"`
+	CharacterClass           string `json:"character_class"`
+	CharacterClassLevel      string `json:"character_class_level"`
+	CharacterLevel           int64  `json:"character_level"`
+	CharacterExp             int64  `json:"character_exp"`
+	CharacterExpRate         string `json:"character_exp_rate"`
+	CharacterGuildName       string `json:"character_guild_name"`
+	CharacterImage           string `json:"character_image"`
+	CharacterDateCreate      string `json:"character_date_create"`
+	AccessFlag               string `json:"access_flag"`
+	LiberationQuestClearFlag string `json:"liberation_quest_clear_flag"`
 }
 
 // GetCharacterOCID fetches the OCID for a character by name
@@ -24,12 +48,12 @@ func (c *Client) GetCharacterOCID(ctx context.Context, characterName string) (*C
 	endpoint := fmt.Sprintf("%s/maplestory/v1/id?character_name=%s", BaseURL, url.QueryEscape(characterName))
 
 	var result CharacterOCID
-	if err := c.get(endpoint, &result); err != nil {
+	if err := c.get(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
 	if result.OCID == "" {
-		return nil, fmt.Errorf("캐릭터를 찾을 수 없습니다: %s", characterName)
+		return nil, i18n.Errorf("nexon.character_not_found", characterName)
 	}
 
 	return &result, nil
@@ -40,7 +64,25 @@ func (c *Client) GetCharacterBasic(ctx context.Context, ocid string) (*Character
 	endpoint := fmt.Sprintf("%s/maplestory/v1/character/basic?ocid=%s", BaseURL, url.QueryEscape(ocid))
 
 	var result CharacterBasic
-	if err := c.get(endpoint, &result); err != nil {
+	if err := c.get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCharacterBasicAt fetches basic character information as of the end of the given day
+// Only past days from FirstHistoryDate up to yesterday (KST) are available
+func (c *Client) GetCharacterBasicAt(ctx context.Context, ocid string, date time.Time) (*CharacterBasic, error) {
+	if date.Before(FirstHistoryDate) {
+		return nil, i18n.Errorf("nexon.history_unavailable", FormatDate(FirstHistoryDate))
+	}
+
+	endpoint := fmt.Sprintf("%s/maplestory/v1/character/basic?ocid=%s&date=%s",
+		BaseURL, url.QueryEscape(ocid), FormatDate(date))
+
+	var result CharacterBasic
+	if err := c.get(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
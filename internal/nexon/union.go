@@ -0,0 +1,29 @@
+package nexon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// UserUnion represents the response from /maplestory/v1/user/union
+type UserUnion struct {
+	Date               string `json:"date"`
+	UnionLevel         int    `json:"union_level"`
+	UnionGrade         string `json:"union_grade"`
+	UnionArtifactLevel int    `json:"union_artifact_level"`
+	UnionArtifactExp   int64  `json:"union_artifact_exp"`
+	UnionArtifactPoint int    `json:"union_artifact_point"`
+}
+
+// GetUserUnion fetches the union (legion) information of a character's account
+func (c *Client) GetUserUnion(ctx context.Context, ocid string) (*UserUnion, error) {
+	endpoint := fmt.Sprintf("%s/maplestory/v1/user/union?ocid=%s", BaseURL, url.QueryEscape(ocid))
+
+	var result UserUnion
+	if err := c.get(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
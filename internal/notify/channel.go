@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ChannelSink sends embeds to channels through the bot's Discord session
+type ChannelSink struct {
+	session *discordgo.Session
+}
+
+// NewChannelSink creates a sink that posts as the bot
+func NewChannelSink(session *discordgo.Session) *ChannelSink {
+	return &ChannelSink{session: session}
+}
+
+// Kind returns KindChannel
+func (s *ChannelSink) Kind() Kind {
+	return KindChannel
+}
+
+// Send posts the message embed, its components and mentions to the target channel
+func (s *ChannelSink) Send(ctx context.Context, target Target, msg *Message) error {
+	_, err := s.session.ChannelMessageSendComplex(target.Address, &discordgo.MessageSend{
+		Content:         msg.mentionContent(),
+		Embeds:          []*discordgo.MessageEmbed{msg.Embed},
+		Components:      msg.Components,
+		AllowedMentions: msg.allowedMentions(),
+	}, discordgo.WithContext(ctx))
+	return err
+}
+
+// DMSink sends embeds to users as direct messages from the bot
+type DMSink struct {
+	session *discordgo.Session
+
+	// DM channel IDs by user ID
+	mu       sync.Mutex
+	channels map[string]string
+}
+
+// NewDMSink creates a sink that messages users as the bot
+func NewDMSink(session *discordgo.Session) *DMSink {
+	return &DMSink{session: session, channels: make(map[string]string)}
+}
+
+// Kind returns KindDM
+func (s *DMSink) Kind() Kind {
+	return KindDM
+}
+
+// Send posts the message embed and its components to the target user
+func (s *DMSink) Send(ctx context.Context, target Target, msg *Message) error {
+	channelID, err := s.channel(ctx, target.Address)
+	if err != nil {
+		return err
+	}
+	_, err = s.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{msg.Embed},
+		Components: msg.Components,
+	}, discordgo.WithContext(ctx))
+	return err
+}
+
+// channel returns the DM channel with a user, opening it on first use
+func (s *DMSink) channel(ctx context.Context, userID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, ok := s.channels[userID]; ok {
+		return id, nil
+	}
+	ch, err := s.session.UserChannelCreate(userID, discordgo.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to open DM channel: %w", err)
+	}
+	s.channels[userID] = ch.ID
+	return ch.ID, nil
+}
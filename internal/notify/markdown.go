@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Discord markdown patterns understood by the converters
+var (
+	mdLink      = regexp.MustCompile(`\[([^\]]+)\]\((https?://[^)\s]+)\)`)
+	mdBold      = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	mdUnderline = regexp.MustCompile(`__(.+?)__`)
+	mdStrike    = regexp.MustCompile(`~~(.+?)~~`)
+	mdItalic    = regexp.MustCompile(`\*([^*\s][^*]*?)\*`)
+	mdCode      = regexp.MustCompile("`([^`]+)`")
+)
+
+// boldMark temporarily stands in for bold markers so they are not read as italics
+const boldMark = "\x00"
+
+// escapeHTML escapes the characters that are significant in Telegram HTML and Slack mrkdwn
+var escapeHTML = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+// toSlackMrkdwn converts Discord markdown to Slack mrkdwn
+func toSlackMrkdwn(text string) string {
+	text = escapeHTML(text)
+	text = mdLink.ReplaceAllString(text, "<$2|$1>")
+	text = mdBold.ReplaceAllString(text, boldMark+"$1"+boldMark)
+	text = mdUnderline.ReplaceAllString(text, "_${1}_")
+	text = mdItalic.ReplaceAllString(text, "_${1}_")
+	text = mdStrike.ReplaceAllString(text, "~$1~")
+	return strings.ReplaceAll(text, boldMark, "*")
+}
+
+// toTelegramHTML converts Discord markdown to Telegram HTML
+func toTelegramHTML(text string) string {
+	text = escapeHTML(text)
+	text = mdCode.ReplaceAllString(text, "<code>$1</code>")
+	text = mdLink.ReplaceAllString(text, `<a href="$2">$1</a>`)
+	text = mdBold.ReplaceAllString(text, "<b>$1</b>")
+	text = mdUnderline.ReplaceAllString(text, "<u>$1</u>")
+	text = mdItalic.ReplaceAllString(text, "<i>$1</i>")
+	text = mdStrike.ReplaceAllString(text, "<s>$1</s>")
+	return text
+}
+
+// truncateRunes shortens text to at most max runes, ending with an ellipsis if cut
+func truncateRunes(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,119 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// Kind identifies a sink implementation
+type Kind string
+
+const (
+	KindChannel        Kind = "channel"         // a channel of the bot's own Discord session
+	KindDM             Kind = "dm"              // a direct message from the bot to a user
+	KindDiscordWebhook Kind = "discord_webhook" // a Discord incoming webhook
+	KindWebhook        Kind = "webhook"         // a generic outgoing JSON webhook
+)
+
+// Target is a single delivery destination
+type Target struct {
+	Kind    Kind
+	Address string // channel ID, user ID or webhook URL
+
+	// Username and AvatarURL override the sender shown by Discord webhooks
+	Username  string
+	AvatarURL string
+}
+
+// Message is a notification ready to be delivered
+type Message struct {
+	GuildID    string // guild ID, or user ID for personal notifications
+	GameType   game.GameType
+	GameName   string
+	PlayerID   string
+	PlayerName string
+	Events     []game.Event
+	Embed      *discordgo.MessageEmbed
+	Locale     i18n.Locale // language of the embed, used for labels added by sinks
+
+	// Components are interactive buttons, only delivered by the bot itself
+	Components []discordgo.MessageComponent
+
+	// MentionUsers and MentionRoles are pinged above the embed by Discord sinks
+	MentionUsers []string
+	MentionRoles []string
+}
+
+// mentionContent returns the message text pinging the message's mentions
+func (m *Message) mentionContent() string {
+	var mentions []string
+	for _, id := range m.MentionUsers {
+		mentions = append(mentions, "<@"+id+">")
+	}
+	for _, id := range m.MentionRoles {
+		mentions = append(mentions, "<@&"+id+">")
+	}
+	return strings.Join(mentions, " ")
+}
+
+// allowedMentions limits pings to the message's mentions, so text inside
+// embeds or player names can never ping anyone
+func (m *Message) allowedMentions() *discordgo.MessageAllowedMentions {
+	return &discordgo.MessageAllowedMentions{
+		Parse: []discordgo.AllowedMentionType{},
+		Users: m.MentionUsers,
+		Roles: m.MentionRoles,
+	}
+}
+
+// Sink delivers messages to targets of one kind
+type Sink interface {
+	// Kind returns the target kind this sink handles
+	Kind() Kind
+
+	// Send delivers a message to a target
+	Send(ctx context.Context, target Target, msg *Message) error
+}
+
+// Dispatcher routes messages to the sink matching each target's kind
+type Dispatcher struct {
+	sinks map[Kind]Sink
+}
+
+// NewDispatcher creates a dispatcher from a set of sinks
+func NewDispatcher(sinks ...Sink) *Dispatcher {
+	d := &Dispatcher{sinks: make(map[Kind]Sink)}
+	for _, sink := range sinks {
+		d.sinks[sink.Kind()] = sink
+	}
+	return d
+}
+
+// Supports reports whether a sink is available for a target kind
+func (d *Dispatcher) Supports(kind Kind) bool {
+	_, ok := d.sinks[kind]
+	return ok
+}
+
+// Send delivers a message to every target
+// Delivery continues past failures; all errors are returned together
+func (d *Dispatcher) Send(ctx context.Context, targets []Target, msg *Message) error {
+	var errs []error
+	for _, target := range targets {
+		sink, ok := d.sinks[target.Kind]
+		if !ok {
+			errs = append(errs, fmt.Errorf("no sink for %s", target.Kind))
+			continue
+		}
+		if err := sink.Send(ctx, target, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Kind, err))
+		}
+	}
+	return errors.Join(errs...)
+}
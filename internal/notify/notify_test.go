@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestDispatcherRoutesByKind(t *testing.T) {
+	channel := NewRecorder(KindChannel)
+	webhook := NewRecorder(KindWebhook)
+	d := NewDispatcher(channel, webhook)
+
+	msg := &Message{PlayerName: "Hide on bush", Embed: &discordgo.MessageEmbed{Title: "Victory"}}
+	targets := []Target{
+		{Kind: KindChannel, Address: "123"},
+		{Kind: KindWebhook, Address: "https://example.com/hook"},
+		{Kind: KindChannel, Address: "456"},
+	}
+	if err := d.Send(context.Background(), targets, msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	got := channel.Deliveries()
+	if len(got) != 2 || got[0].Target.Address != "123" || got[1].Target.Address != "456" {
+		t.Errorf("channel deliveries = %+v", got)
+	}
+	if got := webhook.Deliveries(); len(got) != 1 || got[0].Message.Embed.Title != "Victory" {
+		t.Errorf("webhook deliveries = %+v", got)
+	}
+}
+
+func TestDispatcherContinuesPastFailures(t *testing.T) {
+	failing := NewRecorder(KindSlack)
+	failing.SetError(errors.New("slack is down"))
+	channel := NewRecorder(KindChannel)
+	d := NewDispatcher(failing, channel)
+
+	targets := []Target{
+		{Kind: KindSlack, Address: "https://hooks.slack.com/services/x"},
+		{Kind: KindTelegram, Address: "-100123"},
+		{Kind: KindChannel, Address: "123"},
+	}
+	err := d.Send(context.Background(), targets, &Message{})
+	if err == nil {
+		t.Fatal("Send: want the failures reported")
+	}
+	for _, want := range []string{"slack is down", "no sink for telegram"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Send error = %q, want it to contain %q", err, want)
+		}
+	}
+
+	if got := channel.Deliveries(); len(got) != 1 {
+		t.Errorf("channel deliveries = %d, want 1 despite the earlier failures", len(got))
+	}
+}
+
+func TestDispatcherSupports(t *testing.T) {
+	d := NewDispatcher(NewRecorder(KindChannel))
+
+	if !d.Supports(KindChannel) {
+		t.Error("Supports(channel) = false, want true")
+	}
+	if d.Supports(KindTelegram) {
+		t.Error("Supports(telegram) = true, want false without a telegram sink")
+	}
+}
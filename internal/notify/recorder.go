@@ -0,0 +1,68 @@
+package notify
+
+import (
+	"context"
+	"sync"
+)
+
+// Delivery is a message recorded by a Recorder
+type Delivery struct {
+	Target  Target
+	Message Message
+}
+
+// Recorder is a sink that keeps every message in memory instead of sending it
+// It is meant for integration tests
+type Recorder struct {
+	kind Kind
+
+	mu         sync.Mutex
+	deliveries []Delivery
+	err        error
+}
+
+// NewRecorder creates a recorder that handles targets of the given kind
+func NewRecorder(kind Kind) *Recorder {
+	return &Recorder{kind: kind}
+}
+
+// Kind returns the kind the recorder was created for
+func (r *Recorder) Kind() Kind {
+	return r.kind
+}
+
+// Send records the message
+func (r *Recorder) Send(ctx context.Context, target Target, msg *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+	r.deliveries = append(r.deliveries, Delivery{Target: target, Message: *msg})
+	return nil
+}
+
+// SetError makes subsequent sends fail with err (nil restores success)
+func (r *Recorder) SetError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.err = err
+}
+
+// Deliveries returns a copy of the recorded deliveries
+func (r *Recorder) Deliveries() []Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Delivery(nil), r.deliveries...)
+}
+
+// Reset clears the recorded deliveries
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries = nil
+}
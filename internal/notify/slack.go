@@ -0,0 +1,155 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// KindSlack is a Slack incoming webhook
+const KindSlack Kind = "slack"
+
+// Slack Block Kit limits
+const (
+	slackHeaderMax      = 150
+	slackSectionTextMax = 3000
+	slackFieldMax       = 2000
+	slackFieldsPerBlock = 10
+)
+
+// SlackMessage is the body of a Slack incoming webhook request
+// Blocks are wrapped in an attachment so the embed color is kept as the side bar
+type SlackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []SlackAttachment `json:"attachments,omitempty"`
+}
+
+// SlackAttachment is a colored container of blocks
+type SlackAttachment struct {
+	Color  string       `json:"color,omitempty"`
+	Blocks []SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a Block Kit layout block
+type SlackBlock struct {
+	Type      string       `json:"type"`
+	Text      *SlackText   `json:"text,omitempty"`
+	Fields    []*SlackText `json:"fields,omitempty"`
+	Accessory *SlackImage  `json:"accessory,omitempty"`
+	Elements  []*SlackText `json:"elements,omitempty"`
+	ImageURL  string       `json:"image_url,omitempty"`
+	AltText   string       `json:"alt_text,omitempty"`
+}
+
+// SlackText is a Block Kit text object
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackImage is a Block Kit image element
+type SlackImage struct {
+	Type     string `json:"type"`
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+// mrkdwn returns a Slack mrkdwn text object
+func mrkdwn(text string) *SlackText {
+	return &SlackText{Type: "mrkdwn", Text: text}
+}
+
+// EmbedToSlack converts a Discord embed into a Slack Block Kit message
+// l is the language of the labels added around the embed
+func EmbedToSlack(l i18n.Locale, embed *discordgo.MessageEmbed) *SlackMessage {
+	var blocks []SlackBlock
+
+	if embed.Title != "" {
+		blocks = append(blocks, SlackBlock{
+			Type: "header",
+			Text: &SlackText{Type: "plain_text", Text: truncateRunes(embed.Title, slackHeaderMax)},
+		})
+	}
+
+	var intro []string
+	if embed.Author != nil && embed.Author.Name != "" {
+		intro = append(intro, "*"+escapeHTML(embed.Author.Name)+"*")
+	}
+	if embed.Description != "" {
+		intro = append(intro, toSlackMrkdwn(embed.Description))
+	}
+	if embed.URL != "" {
+		intro = append(intro, fmt.Sprintf("<%s|%s>", embed.URL, l.T("notify.view_details")))
+	}
+	if len(intro) > 0 || embed.Thumbnail != nil {
+		block := SlackBlock{
+			Type: "section",
+			Text: mrkdwn(truncateRunes(strings.Join(intro, "\n"), slackSectionTextMax)),
+		}
+		if block.Text.Text == "" {
+			block.Text.Text = " "
+		}
+		if embed.Thumbnail != nil && embed.Thumbnail.URL != "" {
+			block.Accessory = &SlackImage{Type: "image", ImageURL: embed.Thumbnail.URL, AltText: "thumbnail"}
+		}
+		blocks = append(blocks, block)
+	}
+
+	var fields []*SlackText
+	for _, f := range embed.Fields {
+		text := fmt.Sprintf("*%s*\n%s", escapeHTML(f.Name), toSlackMrkdwn(f.Value))
+		fields = append(fields, mrkdwn(truncateRunes(text, slackFieldMax)))
+	}
+	for len(fields) > 0 {
+		n := min(len(fields), slackFieldsPerBlock)
+		blocks = append(blocks, SlackBlock{Type: "section", Fields: fields[:n]})
+		fields = fields[n:]
+	}
+
+	if embed.Image != nil && embed.Image.URL != "" {
+		blocks = append(blocks, SlackBlock{Type: "image", ImageURL: embed.Image.URL, AltText: "image"})
+	}
+
+	if embed.Footer != nil && embed.Footer.Text != "" {
+		blocks = append(blocks, SlackBlock{
+			Type:     "context",
+			Elements: []*SlackText{mrkdwn(escapeHTML(embed.Footer.Text))},
+		})
+	}
+
+	msg := &SlackMessage{
+		Text:        embed.Title,
+		Attachments: []SlackAttachment{{Blocks: blocks}},
+	}
+	if embed.Color != 0 {
+		msg.Attachments[0].Color = fmt.Sprintf("#%06x", embed.Color)
+	}
+	return msg
+}
+
+// SlackSink posts embeds to Slack incoming webhooks
+type SlackSink struct {
+	httpClient *http.Client
+}
+
+// NewSlackSink creates a Slack webhook sink
+func NewSlackSink() *SlackSink {
+	return &SlackSink{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Kind returns KindSlack
+func (s *SlackSink) Kind() Kind {
+	return KindSlack
+}
+
+// Send posts the converted embed to the target webhook URL
+func (s *SlackSink) Send(ctx context.Context, target Target, msg *Message) error {
+	return postJSON(ctx, s.httpClient, target.Address, EmbedToSlack(msg.Locale, msg.Embed))
+}
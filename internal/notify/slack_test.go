@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+// testEmbed is an embed using every part the converters handle
+func testEmbed() *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:       "Victory <Ranked>",
+		URL:         "https://example.com/match?id=1&view=full",
+		Color:       0x2ECC71,
+		Author:      &discordgo.MessageEmbedAuthor{Name: "Faker & Co"},
+		Description: "**Ahri** | *Mid* | [op.gg](https://op.gg/summoners/kr/Faker) | 3 < 5",
+		Thumbnail:   &discordgo.MessageEmbedThumbnail{URL: "https://example.com/ahri.png"},
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "K/D/A", Value: "10 / 2 / 8 (9.00)"},
+			{Name: "Gold <total>", Value: "__15,000__ ~~14,000~~ `gpm`"},
+		},
+		Image:  &discordgo.MessageEmbedImage{URL: "https://example.com/chart.png"},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Match <KR_1>"},
+	}
+}
+
+func TestEmbedToSlack(t *testing.T) {
+	msg := EmbedToSlack(i18n.Korean, testEmbed())
+
+	if msg.Text != "Victory <Ranked>" {
+		t.Errorf("Text = %q, want the plain title as the notification fallback", msg.Text)
+	}
+	if len(msg.Attachments) != 1 || msg.Attachments[0].Color != "#2ecc71" {
+		t.Fatalf("Attachments = %+v, want one with the embed color", msg.Attachments)
+	}
+
+	blocks := msg.Attachments[0].Blocks
+	var types []string
+	for _, b := range blocks {
+		types = append(types, b.Type)
+	}
+	if got := strings.Join(types, ","); got != "header,section,section,image,context" {
+		t.Fatalf("block types = %s", got)
+	}
+
+	// Header text is plain_text, which Slack doesn't parse
+	if blocks[0].Text.Type != "plain_text" || blocks[0].Text.Text != "Victory <Ranked>" {
+		t.Errorf("header = %+v", blocks[0].Text)
+	}
+
+	intro := blocks[1].Text.Text
+	for _, want := range []string{
+		"*Faker &amp; Co*",
+		"*Ahri* | _Mid_ | <https://op.gg/summoners/kr/Faker|op.gg> | 3 &lt; 5",
+		"<https://example.com/match?id=1&view=full|",
+	} {
+		if !strings.Contains(intro, want) {
+			t.Errorf("intro = %q, want it to contain %q", intro, want)
+		}
+	}
+	if blocks[1].Accessory == nil || blocks[1].Accessory.ImageURL != "https://example.com/ahri.png" {
+		t.Errorf("accessory = %+v, want the thumbnail", blocks[1].Accessory)
+	}
+
+	fields := blocks[2].Fields
+	if len(fields) != 2 {
+		t.Fatalf("fields = %d, want 2", len(fields))
+	}
+	if want := "*Gold &lt;total&gt;*\n_15,000_ ~14,000~ `gpm`"; fields[1].Text != want {
+		t.Errorf("field = %q, want %q", fields[1].Text, want)
+	}
+
+	if blocks[3].ImageURL != "https://example.com/chart.png" {
+		t.Errorf("image = %q", blocks[3].ImageURL)
+	}
+	if got := blocks[4].Elements[0].Text; got != "Match &lt;KR_1&gt;" {
+		t.Errorf("footer = %q", got)
+	}
+}
+
+func TestEmbedToSlackLimits(t *testing.T) {
+	embed := &discordgo.MessageEmbed{Title: strings.Repeat("가", 200)}
+	for range 13 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "n", Value: "v"})
+	}
+
+	blocks := EmbedToSlack(i18n.Korean, embed).Attachments[0].Blocks
+	if n := len([]rune(blocks[0].Text.Text)); n != slackHeaderMax {
+		t.Errorf("header length = %d, want %d", n, slackHeaderMax)
+	}
+	// 13 fields need two sections of at most 10
+	if len(blocks) != 3 || len(blocks[1].Fields) != 10 || len(blocks[2].Fields) != 3 {
+		t.Errorf("blocks = %+v, want fields split 10 + 3", blocks)
+	}
+}
+
+func TestSlackSinkSend(t *testing.T) {
+	var got SlackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request = %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	err := NewSlackSink().Send(context.Background(), Target{Kind: KindSlack, Address: server.URL}, &Message{Embed: testEmbed()})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if got.Text != "Victory <Ranked>" || len(got.Attachments) != 1 || len(got.Attachments[0].Blocks) != 5 {
+		t.Errorf("posted message = %+v", got)
+	}
+}
+
+func TestSlackSinkErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_blocks", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := NewSlackSink().Send(context.Background(), Target{Kind: KindSlack, Address: server.URL}, &Message{Embed: testEmbed()})
+	if err == nil || !strings.Contains(err.Error(), "status 400") || !strings.Contains(err.Error(), "invalid_blocks") {
+		t.Errorf("Send error = %v, want the status and body", err)
+	}
+}
@@ -0,0 +1,230 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+const (
+	// KindTelegram is a Telegram chat, addressed by chat ID or @channel name
+	KindTelegram Kind = "telegram"
+
+	// TelegramBaseURL is the Telegram Bot API endpoint
+	TelegramBaseURL = "https://api.telegram.org"
+
+	// telegramMessageMax is the maximum length of a Telegram message
+	telegramMessageMax = 4096
+
+	// telegramPostTTL is how long messages read from the bot's updates are
+	// kept for ChatPosted; Telegram hands out each update only once
+	telegramPostTTL = 30 * time.Minute
+)
+
+// EmbedToTelegramHTML converts a Discord embed into a Telegram HTML message
+// l is the language of the labels added around the embed
+func EmbedToTelegramHTML(l i18n.Locale, embed *discordgo.MessageEmbed) string {
+	var parts []string
+
+	if embed.Title != "" {
+		title := "<b>" + escapeHTML(embed.Title) + "</b>"
+		if embed.URL != "" {
+			title = fmt.Sprintf(`<a href="%s">%s</a>`, escapeHTML(embed.URL), title)
+		}
+		parts = append(parts, title)
+	}
+	if embed.Author != nil && embed.Author.Name != "" {
+		parts = append(parts, "<i>"+escapeHTML(embed.Author.Name)+"</i>")
+	}
+	if embed.Description != "" {
+		parts = append(parts, toTelegramHTML(embed.Description))
+	}
+
+	for _, f := range embed.Fields {
+		parts = append(parts, fmt.Sprintf("<b>%s</b>\n%s", escapeHTML(f.Name), toTelegramHTML(f.Value)))
+	}
+
+	if embed.Image != nil && embed.Image.URL != "" {
+		parts = append(parts, fmt.Sprintf(`<a href="%s">%s</a>`, escapeHTML(embed.Image.URL), escapeHTML(l.T("notify.image"))))
+	}
+	if embed.Footer != nil && embed.Footer.Text != "" {
+		parts = append(parts, "<i>"+escapeHTML(embed.Footer.Text)+"</i>")
+	}
+
+	text := strings.Join(parts, "\n\n")
+	if len([]rune(text)) > telegramMessageMax {
+		// Cutting HTML could leave an open tag; fall back to the title and description only
+		text = truncateRunes(strings.Join(parts[:min(len(parts), 2)], "\n\n"), telegramMessageMax)
+	}
+	return text
+}
+
+// TelegramSink sends embeds through a Telegram bot
+type TelegramSink struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+
+	// mu guards the messages read from the bot's updates
+	mu     sync.Mutex
+	offset int64
+	posts  []telegramPost
+}
+
+// telegramPost is a message the bot saw in a chat
+type telegramPost struct {
+	chatID   string
+	username string
+	text     string
+	seen     time.Time
+}
+
+// NewTelegramSink creates a Telegram sink for a bot token
+func NewTelegramSink(token string) *TelegramSink {
+	return NewTelegramSinkWithBaseURL(token, TelegramBaseURL)
+}
+
+// NewTelegramSinkWithBaseURL creates a Telegram sink against a custom API endpoint
+func NewTelegramSinkWithBaseURL(token, baseURL string) *TelegramSink {
+	return &TelegramSink{
+		token:      token,
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Kind returns KindTelegram
+func (s *TelegramSink) Kind() Kind {
+	return KindTelegram
+}
+
+// telegramSendMessage is the body of a sendMessage request
+type telegramSendMessage struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+// Send posts the converted embed to the target chat
+func (s *TelegramSink) Send(ctx context.Context, target Target, msg *Message) error {
+	endpoint := fmt.Sprintf("%s/bot%s/sendMessage", s.baseURL, s.token)
+	err := postJSON(ctx, s.httpClient, endpoint, telegramSendMessage{
+		ChatID:                target.Address,
+		Text:                  EmbedToTelegramHTML(msg.Locale, msg.Embed),
+		ParseMode:             "HTML",
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		// Transport errors include the request URL; keep the token out of logs
+		return errors.New(strings.ReplaceAll(err.Error(), s.token, "<token>"))
+	}
+	return nil
+}
+
+// telegramChat is the chat of a message in an update
+type telegramChat struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// telegramMessage is a message or channel post in an update
+type telegramMessage struct {
+	Chat telegramChat `json:"chat"`
+	Text string       `json:"text"`
+}
+
+// telegramUpdate is one entry of a getUpdates response
+type telegramUpdate struct {
+	UpdateID    int64            `json:"update_id"`
+	Message     *telegramMessage `json:"message"`
+	ChannelPost *telegramMessage `json:"channel_post"`
+}
+
+// ChatPosted reports whether text was posted in a chat, addressed by chat ID
+// or @channel name, since the bot last read its updates within telegramPostTTL
+// Groups in privacy mode only show the bot commands, so text should be one
+func (s *TelegramSink) ChatPosted(ctx context.Context, chat, text string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.readUpdates(ctx); err != nil {
+		return false, err
+	}
+	for _, post := range s.posts {
+		if post.chatID != chat && !strings.EqualFold("@"+post.username, chat) {
+			continue
+		}
+		if strings.Contains(post.text, text) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readUpdates appends the bot's new messages to s.posts and drops old ones
+func (s *TelegramSink) readUpdates(ctx context.Context) error {
+	endpoint := fmt.Sprintf("%s/bot%s/getUpdates", s.baseURL, s.token)
+	body, err := json.Marshal(map[string]any{
+		"offset":          s.offset,
+		"allowed_updates": []string{"message", "channel_post"},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.New(strings.ReplaceAll(err.Error(), s.token, "<token>"))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return errors.New(strings.ReplaceAll(err.Error(), s.token, "<token>"))
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool             `json:"ok"`
+		Description string           `json:"description"`
+		Result      []telegramUpdate `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode updates (status %d): %w", resp.StatusCode, err)
+	}
+	if !result.OK {
+		return fmt.Errorf("getUpdates failed (status %d): %s", resp.StatusCode, result.Description)
+	}
+
+	now := time.Now()
+	s.posts = slices.DeleteFunc(s.posts, func(p telegramPost) bool { return now.Sub(p.seen) > telegramPostTTL })
+	for _, update := range result.Result {
+		s.offset = max(s.offset, update.UpdateID+1)
+		msg := update.Message
+		if msg == nil {
+			msg = update.ChannelPost
+		}
+		if msg == nil || msg.Text == "" {
+			continue
+		}
+		s.posts = append(s.posts, telegramPost{
+			chatID:   strconv.FormatInt(msg.Chat.ID, 10),
+			username: msg.Chat.Username,
+			text:     msg.Text,
+			seen:     now,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/i18n"
+)
+
+func TestEmbedToTelegramHTML(t *testing.T) {
+	text := EmbedToTelegramHTML(i18n.Korean, testEmbed())
+
+	want := strings.Join([]string{
+		`<a href="https://example.com/match?id=1&amp;view=full"><b>Victory &lt;Ranked&gt;</b></a>`,
+		`<i>Faker &amp; Co</i>`,
+		`<b>Ahri</b> | <i>Mid</i> | <a href="https://op.gg/summoners/kr/Faker">op.gg</a> | 3 &lt; 5`,
+		"<b>K/D/A</b>\n10 / 2 / 8 (9.00)",
+		"<b>Gold &lt;total&gt;</b>\n<u>15,000</u> <s>14,000</s> <code>gpm</code>",
+		`<a href="https://example.com/chart.png">이미지</a>`,
+		`<i>Match &lt;KR_1&gt;</i>`,
+	}, "\n\n")
+	if text != want {
+		t.Errorf("EmbedToTelegramHTML =\n%s\nwant\n%s", text, want)
+	}
+}
+
+func TestEmbedToTelegramHTMLTooLong(t *testing.T) {
+	embed := &discordgo.MessageEmbed{Title: "Title", Description: "Description"}
+	for range 10 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "n", Value: strings.Repeat("x", 500)})
+	}
+
+	// Fields are dropped rather than cut in the middle of a tag
+	if got, want := EmbedToTelegramHTML(i18n.Korean, embed), "<b>Title</b>\n\nDescription"; got != want {
+		t.Errorf("EmbedToTelegramHTML = %q, want %q", got, want)
+	}
+}
+
+func TestTelegramSinkSend(t *testing.T) {
+	var path string
+	var got telegramSendMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	sink := NewTelegramSinkWithBaseURL("123:secret", server.URL)
+	err := sink.Send(context.Background(), Target{Kind: KindTelegram, Address: "@esports"}, &Message{Embed: testEmbed()})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if path != "/bot123:secret/sendMessage" {
+		t.Errorf("path = %q", path)
+	}
+	if got.ChatID != "@esports" || got.ParseMode != "HTML" || !got.DisableWebPagePreview {
+		t.Errorf("sendMessage = %+v", got)
+	}
+	if got.Text != EmbedToTelegramHTML(i18n.Korean, testEmbed()) {
+		t.Errorf("text = %q, want the converted embed", got.Text)
+	}
+}
+
+func TestTelegramSinkErrorHidesToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"ok":false,"description":"Bad Request: chat not found"}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sink := NewTelegramSinkWithBaseURL("123:secret", server.URL)
+	err := sink.Send(context.Background(), Target{Kind: KindTelegram, Address: "-100"}, &Message{Embed: testEmbed()})
+	if err == nil || !strings.Contains(err.Error(), "status 400") || !strings.Contains(err.Error(), "chat not found") {
+		t.Fatalf("Send error = %v, want the status and description", err)
+	}
+
+	// Transport errors carry the request URL, which includes the token
+	server.Close()
+	err = sink.Send(context.Background(), Target{Kind: KindTelegram, Address: "-100"}, &Message{Embed: testEmbed()})
+	if err == nil {
+		t.Fatal("Send to a closed server: want error")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Send error = %q, want the token redacted", err)
+	}
+}
+
+func TestTelegramSinkChatPosted(t *testing.T) {
+	batches := []string{
+		`{"ok":true,"result":[
+			{"update_id":10,"message":{"chat":{"id":-1001,"type":"supergroup"},"text":"/verify_abc"}},
+			{"update_id":11,"channel_post":{"chat":{"id":-1002,"username":"EsportsNews","type":"channel"},"text":"/verify_def"}}
+		]}`,
+		`{"ok":true,"result":[]}`,
+	}
+	var offsets []float64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bot123:secret/getUpdates" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		offsets = append(offsets, body["offset"].(float64))
+
+		batch := batches[min(len(offsets)-1, len(batches)-1)]
+		w.Write([]byte(batch))
+	}))
+	defer server.Close()
+
+	sink := NewTelegramSinkWithBaseURL("123:secret", server.URL)
+	tests := []struct {
+		chat, text string
+		want       bool
+	}{
+		{"-1001", "/verify_abc", true},
+		{"-1001", "/verify_def", false},
+		{"@esportsnews", "/verify_def", true}, // seen in an earlier read
+		{"-1003", "/verify_abc", false},
+	}
+	for _, tt := range tests {
+		got, err := sink.ChatPosted(context.Background(), tt.chat, tt.text)
+		if err != nil {
+			t.Fatalf("ChatPosted: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("ChatPosted(%q, %q) = %v, want %v", tt.chat, tt.text, got, tt.want)
+		}
+	}
+
+	// Updates are confirmed so each one is read once
+	if len(offsets) < 2 || offsets[0] != 0 || offsets[1] != 12 {
+		t.Errorf("offsets = %v, want 0 then 12", offsets)
+	}
+}
+
+func TestTelegramSinkChatPostedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"ok":false,"description":"Conflict: can't use getUpdates method while webhook is active"}`))
+	}))
+	defer server.Close()
+
+	sink := NewTelegramSinkWithBaseURL("123:secret", server.URL)
+	if _, err := sink.ChatPosted(context.Background(), "-1001", "/verify_abc"); err == nil || !strings.Contains(err.Error(), "webhook is active") {
+		t.Errorf("ChatPosted error = %v, want the API description", err)
+	}
+}
@@ -0,0 +1,212 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+)
+
+// postJSON sends a JSON body and treats any non-2xx status as an error
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+// ErrPrivateAddress is returned for webhook hosts that resolve to loopback,
+// private, link-local or reserved addresses, which must not be reachable from guilds
+var ErrPrivateAddress = errors.New("webhook address is not public")
+
+// reservedNets are ranges the net.IP predicates don't cover: "this network"
+// and carrier-grade NAT, which can reach hosts inside the provider's network
+var reservedNets = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// isPublicIP reports whether ip is a routable public address
+func isPublicIP(ip net.IP) bool {
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+// CheckWebhookURL checks that a webhook URL is https and that every address
+// its host resolves to is public
+func CheckWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("invalid webhook URL %q", rawURL)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", u.Hostname(), err)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrPrivateAddress, u.Hostname(), addr.IP)
+		}
+	}
+	return nil
+}
+
+// publicOnlyClient returns an HTTP client that refuses to connect to
+// non-public addresses; the check runs on the resolved address at dial time
+// so a host can't pass CheckWebhookURL and later resolve elsewhere
+func publicOnlyClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: 10 * time.Second, Transport: transport}
+}
+
+// DiscordWebhookSink sends embeds to Discord incoming webhooks
+type DiscordWebhookSink struct {
+	httpClient *http.Client
+}
+
+// NewDiscordWebhookSink creates a Discord webhook sink
+func NewDiscordWebhookSink() *DiscordWebhookSink {
+	return &DiscordWebhookSink{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Kind returns KindDiscordWebhook
+func (s *DiscordWebhookSink) Kind() Kind {
+	return KindDiscordWebhook
+}
+
+// discordWebhookPayload is the body of a Discord execute-webhook request
+type discordWebhookPayload struct {
+	Username        string                            `json:"username,omitempty"`
+	AvatarURL       string                            `json:"avatar_url,omitempty"`
+	Content         string                            `json:"content,omitempty"`
+	Embeds          []*discordgo.MessageEmbed         `json:"embeds"`
+	AllowedMentions *discordgo.MessageAllowedMentions `json:"allowed_mentions"`
+}
+
+// Send executes the webhook; the username defaults to the game name
+func (s *DiscordWebhookSink) Send(ctx context.Context, target Target, msg *Message) error {
+	username := target.Username
+	if username == "" {
+		username = msg.GameName
+	}
+	return postJSON(ctx, s.httpClient, target.Address, discordWebhookPayload{
+		Username:        username,
+		AvatarURL:       target.AvatarURL,
+		Content:         msg.mentionContent(),
+		Embeds:          []*discordgo.MessageEmbed{msg.Embed},
+		AllowedMentions: msg.allowedMentions(),
+	})
+}
+
+// WebhookSink posts notifications as generic JSON, e.g. for dashboards
+// It only connects to public addresses since guilds choose the URL
+type WebhookSink struct {
+	httpClient *http.Client
+}
+
+// NewWebhookSink creates a generic JSON webhook sink
+func NewWebhookSink() *WebhookSink {
+	return &WebhookSink{
+		httpClient: publicOnlyClient(),
+	}
+}
+
+// Kind returns KindWebhook
+func (s *WebhookSink) Kind() Kind {
+	return KindWebhook
+}
+
+// WebhookPayload is the body posted by WebhookSink
+type WebhookPayload struct {
+	GuildID    string                  `json:"guild_id"`
+	Game       game.GameType           `json:"game"`
+	PlayerID   string                  `json:"player_id"`
+	PlayerName string                  `json:"player_name"`
+	Events     []WebhookEvent          `json:"events"`
+	Embed      *discordgo.MessageEmbed `json:"embed"`
+	SentAt     time.Time               `json:"sent_at"`
+}
+
+// WebhookEvent is a game event in a WebhookPayload
+type WebhookEvent struct {
+	Type    game.EventType `json:"type"`
+	Summary string         `json:"summary"`
+}
+
+// Send posts the message as a WebhookPayload
+func (s *WebhookSink) Send(ctx context.Context, target Target, msg *Message) error {
+	events := make([]WebhookEvent, len(msg.Events))
+	for i, e := range msg.Events {
+		events[i] = WebhookEvent{Type: e.Type, Summary: e.Summary}
+	}
+	return postJSON(ctx, s.httpClient, target.Address, WebhookPayload{
+		GuildID:    msg.GuildID,
+		Game:       msg.GameType,
+		PlayerID:   msg.PlayerID,
+		PlayerName: msg.PlayerName,
+		Events:     events,
+		Embed:      msg.Embed,
+		SentAt:     time.Now().UTC(),
+	})
+}
@@ -0,0 +1,68 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		private bool
+	}{
+		{"https://127.0.0.1/hook", true},
+		{"https://localhost:8080/hook", true},
+		{"https://10.0.0.5/hook", true},
+		{"https://192.168.1.10/hook", true},
+		{"https://169.254.169.254/latest/meta-data", true},
+		{"https://[::1]/hook", true},
+		{"https://[fe80::1]/hook", true},
+		{"https://0.0.0.0/hook", true},
+	}
+	for _, tt := range tests {
+		err := CheckWebhookURL(context.Background(), tt.url)
+		if got := errors.Is(err, ErrPrivateAddress); got != tt.private {
+			t.Errorf("CheckWebhookURL(%s) = %v, want private %v", tt.url, err, tt.private)
+		}
+	}
+
+	for _, invalid := range []string{"http://example.com/hook", "https:///hook", "not a url"} {
+		if err := CheckWebhookURL(context.Background(), invalid); err == nil {
+			t.Errorf("CheckWebhookURL(%q): want error", invalid)
+		}
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for _, addr := range []string{"8.8.8.8", "1.1.1.1", "100.63.255.255", "100.128.0.1", "2606:4700:4700::1111"} {
+		if !isPublicIP(net.ParseIP(addr)) {
+			t.Errorf("isPublicIP(%s) = false, want true", addr)
+		}
+	}
+	for _, addr := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.0.1", "169.254.1.1", "::1", "fc00::1", "fe80::1", "224.0.0.1",
+		"0.1.2.3", "100.64.0.1", "100.127.255.254", "::ffff:100.64.0.1"} {
+		if isPublicIP(net.ParseIP(addr)) {
+			t.Errorf("isPublicIP(%s) = true, want false", addr)
+		}
+	}
+}
+
+func TestWebhookSinkRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink().Send(context.Background(), Target{Kind: KindWebhook, Address: server.URL}, &Message{})
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Errorf("Send to %s = %v, want ErrPrivateAddress", server.URL, err)
+	}
+	if called {
+		t.Error("webhook on a loopback address was called")
+	}
+}
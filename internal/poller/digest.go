@@ -0,0 +1,199 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/notify"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// burstWindow is the window in which a guild's notifications count toward its burst limit
+const burstWindow = time.Minute
+
+// maxDigestLines caps the notifications listed in a digest embed
+const maxDigestLines = 20
+
+// holdBack reports whether a guild notification is held back instead of sent:
+// dropped or queued for a digest during quiet hours, or collapsed into the
+// poll cycle's summary once the guild exceeds its burst limit
+func (p *Poller) holdBack(ctx context.Context, guildID string, msg *notify.Message) bool {
+	settings, err := p.repo.GetGuildSettings(guildID)
+	if err != nil {
+		settings = storage.DefaultGuildSettings(guildID)
+	}
+	now := time.Now()
+
+	if settings.QuietHours.Active(now) {
+		if settings.QuietMode == storage.QuietDrop {
+			slog.DebugContext(ctx, "Dropping notification during quiet hours", "guildID", guildID, "player", msg.PlayerName)
+			return true
+		}
+		if err := p.repo.QueueNotification(queuedFrom(msg, now)); err != nil {
+			// Better to lose it than to ping members in the middle of the night
+			slog.ErrorContext(ctx, "Failed to queue notification", "guildID", guildID, "error", err)
+		} else {
+			slog.DebugContext(ctx, "Queued notification for digest", "guildID", guildID, "player", msg.PlayerName)
+		}
+		return true
+	}
+
+	if settings.BurstLimit <= 0 {
+		return false
+	}
+	recent := p.recentSends[guildID][:0]
+	for _, t := range p.recentSends[guildID] {
+		if now.Sub(t) < burstWindow {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= settings.BurstLimit {
+		p.recentSends[guildID] = recent
+		p.collapsed[guildID] = append(p.collapsed[guildID], queuedFrom(msg, now))
+		slog.DebugContext(ctx, "Collapsing notification burst", "guildID", guildID, "player", msg.PlayerName)
+		return true
+	}
+	p.recentSends[guildID] = append(recent, now)
+	return false
+}
+
+// flushCollapsed posts one summary per guild for notifications collapsed this cycle
+func (p *Poller) flushCollapsed(ctx context.Context) {
+	for guildID, items := range p.collapsed {
+		if err := p.sendDigest(ctx, guildID, "notify.burst_title", items); err != nil {
+			slog.ErrorContext(ctx, "Failed to send notification summary", "guildID", guildID, "error", err)
+		}
+		delete(p.collapsed, guildID)
+	}
+}
+
+// flushDigests posts the queued notifications of guilds whose quiet hours ended
+func (p *Poller) flushDigests(ctx context.Context) {
+	guildIDs, err := p.repo.GetQueuedGuilds()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get queued notifications", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, guildID := range guildIDs {
+		settings, err := p.repo.GetGuildSettings(guildID)
+		if err == nil && settings.QuietMode == storage.QuietDigest && settings.QuietHours.Active(now) {
+			continue
+		}
+
+		queued, err := p.repo.GetQueuedNotifications(guildID)
+		if err != nil || len(queued) == 0 {
+			continue
+		}
+		if err := p.sendDigest(ctx, guildID, "notify.digest_title", queued); err != nil {
+			slog.ErrorContext(ctx, "Failed to send quiet hours digest", "guildID", guildID, "error", err)
+			continue
+		}
+		if err := p.repo.DeleteQueuedNotifications(guildID, queued[len(queued)-1].ID); err != nil {
+			slog.ErrorContext(ctx, "Failed to clear queued notifications", "guildID", guildID, "error", err)
+		}
+		slog.InfoContext(ctx, "Sent quiet hours digest", "guildID", guildID, "notifications", len(queued))
+	}
+}
+
+// sendDigest posts held back notifications as a single embed per destination
+// Each notification goes where its game's notifications are routed
+func (p *Poller) sendDigest(ctx context.Context, guildID, titleKey string, items []*storage.QueuedNotification) error {
+	var order []notify.Target
+	byTarget := make(map[notify.Target][]*storage.QueuedNotification)
+	for _, item := range items {
+		targets, err := p.targetsFor(guildID, game.GameType(item.GameType))
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			if _, ok := byTarget[target]; !ok {
+				order = append(order, target)
+			}
+			byTarget[target] = append(byTarget[target], item)
+		}
+	}
+	if len(order) == 0 {
+		slog.WarnContext(ctx, "No notification channel set for guild", "guildID", guildID)
+		return nil
+	}
+
+	l := p.localeFor(guildID)
+	var errs []error
+	for _, target := range order {
+		msg := &notify.Message{
+			GuildID: guildID,
+			Embed:   digestEmbed(l, titleKey, byTarget[target]),
+			Locale:  l,
+		}
+		for _, item := range byTarget[target] {
+			for _, id := range item.MentionUsers {
+				msg.MentionUsers = appendUnique(msg.MentionUsers, id)
+			}
+			for _, id := range item.MentionRoles {
+				msg.MentionRoles = appendUnique(msg.MentionRoles, id)
+			}
+		}
+		if err := p.notifier.Send(ctx, []notify.Target{target}, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// digestEmbed lists notifications one per line
+func digestEmbed(l i18n.Locale, titleKey string, items []*storage.QueuedNotification) *discordgo.MessageEmbed {
+	var sb strings.Builder
+	for i, item := range items {
+		if i == maxDigestLines {
+			sb.WriteString(l.T("notify.digest_more", len(items)-i))
+			break
+		}
+
+		gameName := item.GameName
+		if name, ok := l.Lookup("game." + item.GameType); ok {
+			gameName = name
+		}
+		title := item.Title
+		if item.URL != "" {
+			title = fmt.Sprintf("[%s](%s)", title, item.URL)
+		}
+		sb.WriteString(fmt.Sprintf("**%s** · `%s` — %s\n", gameName, item.PlayerName, title))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       l.T(titleKey, len(items)),
+		Description: sb.String(),
+		Color:       0x5865F2,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+}
+
+// queuedFrom keeps the parts of a notification a digest shows and pings
+func queuedFrom(msg *notify.Message, at time.Time) *storage.QueuedNotification {
+	n := &storage.QueuedNotification{
+		GuildID:      msg.GuildID,
+		GameType:     string(msg.GameType),
+		GameName:     msg.GameName,
+		PlayerName:   msg.PlayerName,
+		MentionUsers: msg.MentionUsers,
+		MentionRoles: msg.MentionRoles,
+		CreatedAt:    at,
+	}
+	if msg.Embed != nil {
+		n.Title = msg.Embed.Title
+		n.URL = msg.Embed.URL
+		if n.Title == "" && msg.Embed.Author != nil {
+			n.Title = msg.Embed.Author.Name
+		}
+	}
+	return n
+}
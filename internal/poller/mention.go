@@ -0,0 +1,65 @@
+package poller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/notify"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// addMentions adds the users and roles pinged by a guild's mention rules
+// matching a change; without a matching rule nobody is pinged
+func (p *Poller) addMentions(ctx context.Context, guildID string, summoner *storage.Summoner, tracker game.Tracker, change game.StateChange, msg *notify.Message) {
+	rules, err := p.repo.GetMentionRules(guildID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get mention rules", "guildID", guildID, "error", err)
+		return
+	}
+
+	for _, rule := range rules {
+		if rule.GameType != "" && rule.GameType != string(tracker.Type()) {
+			continue
+		}
+		if !ruleMatches(rule, tracker, change) {
+			continue
+		}
+
+		switch rule.MentionType {
+		case storage.MentionOwner:
+			link, err := p.repo.GetPlayerLink(summoner.ID)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to get player link", "summoner", summoner.RiotID, "error", err)
+				continue
+			}
+			msg.MentionUsers = appendUnique(msg.MentionUsers, link.UserID)
+		case storage.MentionUser:
+			msg.MentionUsers = appendUnique(msg.MentionUsers, rule.MentionID)
+		case storage.MentionRole:
+			msg.MentionRoles = appendUnique(msg.MentionRoles, rule.MentionID)
+		}
+	}
+}
+
+// ruleMatches reports whether a change contains the event of a mention rule
+func ruleMatches(rule *storage.MentionRule, tracker game.Tracker, change game.StateChange) bool {
+	if rule.Event == storage.MentionPromotion {
+		return game.Promoted(tracker, change)
+	}
+	return change.HasEvent(game.EventType(rule.Event))
+}
+
+// appendUnique appends id unless ids already contains it
+func appendUnique(ids []string, id string) []string {
+	if slices.Contains(ids, id) {
+		return ids
+	}
+	return append(ids, id)
+}
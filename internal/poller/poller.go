@@ -2,40 +2,75 @@ package poller
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"runtime/debug"
 	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/notify"
 	"github.com/flor3z/discord-bot/internal/storage"
+	"github.com/flor3z/discord-bot/internal/trace"
 )
 
 // Poller periodically checks for state changes across all registered games
 type Poller struct {
 	repo     *storage.Repository
 	registry *game.Registry
-	discord  *discordgo.Session
+	notifier *notify.Dispatcher
 	interval time.Duration
 
+	// afterPoll, if set, runs after every poll cycle
+	afterPoll func(ctx context.Context)
+
+	// components, if set, builds the buttons attached to a guild's notification
+	components ComponentBuilder
+
+	// Burst tracking per guild, only touched from the polling goroutine:
+	// recent send times and notifications collapsed into the cycle's summary
+	recentSends map[string][]time.Time
+	collapsed   map[string][]*storage.QueuedNotification
+
 	stopChan chan struct{}
 	wg       sync.WaitGroup
 }
 
 // New creates a new Poller with the game registry
-func New(repo *storage.Repository, registry *game.Registry, discord *discordgo.Session, intervalSeconds int) *Poller {
+func New(repo *storage.Repository, registry *game.Registry, notifier *notify.Dispatcher, intervalSeconds int) *Poller {
 	return &Poller{
 		repo:     repo,
 		registry: registry,
-		discord:  discord,
+		notifier: notifier,
 		interval: time.Duration(intervalSeconds) * time.Second,
 		stopChan: make(chan struct{}),
+
+		recentSends: make(map[string][]time.Time),
+		collapsed:   make(map[string][]*storage.QueuedNotification),
 	}
 }
 
+// OnPollComplete registers a function to run after every poll cycle
+// It must be called before Start
+func (p *Poller) OnPollComplete(fn func(ctx context.Context)) {
+	p.afterPoll = fn
+}
+
+// ComponentBuilder returns the message components for a notification sent to
+// a guild or user, labelled in the notification's locale
+type ComponentBuilder func(guildID string, locale i18n.Locale, summoner *storage.Summoner, tracker game.Tracker, change game.StateChange) []discordgo.MessageComponent
+
+// SetComponentBuilder registers the function that adds buttons to notifications
+// It must be called before Start
+func (p *Poller) SetComponentBuilder(fn ComponentBuilder) {
+	p.components = fn
+}
+
 // Start begins the polling loop
 func (p *Poller) Start(ctx context.Context) {
-	slog.Info("Starting poller", "interval", p.interval)
+	slog.InfoContext(ctx, "Starting poller", "interval", p.interval)
 
 	p.wg.Add(1)
 	defer p.wg.Done()
@@ -49,10 +84,10 @@ func (p *Poller) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			slog.Info("Poller stopped (context cancelled)")
+			slog.InfoContext(ctx, "Poller stopped (context cancelled)")
 			return
 		case <-p.stopChan:
-			slog.Info("Poller stopped")
+			slog.InfoContext(ctx, "Poller stopped")
 			return
 		case <-ticker.C:
 			p.poll(ctx)
@@ -70,25 +105,46 @@ func (p *Poller) Stop() {
 func (p *Poller) poll(ctx context.Context) {
 	summoners, err := p.repo.GetAllSummoners()
 	if err != nil {
-		slog.Error("Failed to get summoners", "error", err)
+		slog.ErrorContext(ctx, "Failed to get summoners", "error", err)
 		return
 	}
 
 	if len(summoners) == 0 {
-		slog.Debug("No summoners to poll")
+		slog.DebugContext(ctx, "No summoners to poll")
 		return
 	}
 
-	slog.Debug("Polling summoners", "count", len(summoners))
+	slog.DebugContext(ctx, "Polling summoners", "count", len(summoners))
 
 	for _, summoner := range summoners {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			p.checkSummoner(ctx, summoner)
+			p.check(ctx, summoner)
 		}
 	}
+
+	p.flushCollapsed(ctx)
+	p.flushDigests(ctx)
+
+	if p.afterPoll != nil {
+		p.afterPoll(ctx)
+	}
+}
+
+// check runs one player's check under its own correlation ID, so its logs
+// and API calls can be followed, and keeps a panic from stopping the poller
+func (p *Poller) check(ctx context.Context, summoner *storage.Summoner) {
+	ctx, _ = trace.Start(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "Player check panicked", "summoner", summoner.RiotID,
+				"panic", r, "stack", string(debug.Stack()))
+		}
+	}()
+
+	p.checkSummoner(ctx, summoner)
 }
 
 // checkSummoner checks a single player for state changes
@@ -96,72 +152,253 @@ func (p *Poller) checkSummoner(ctx context.Context, summoner *storage.Summoner)
 	// Get the appropriate tracker for this game
 	tracker, err := p.registry.Get(game.GameType(summoner.GameType))
 	if err != nil {
-		slog.Error("Unknown game type for summoner", "summoner", summoner.RiotID, "gameType", summoner.GameType)
+		slog.ErrorContext(ctx, "Unknown game type for summoner", "summoner", summoner.RiotID, "gameType", summoner.GameType)
+		return
+	}
+
+	// Load the previously stored state (legacy rows are converted by storage)
+	stored, err := p.repo.GetPlayerState(summoner)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to load stored state", "summoner", summoner.RiotID, "error", err)
 		return
 	}
 
 	// Get current state
 	currentState, err := tracker.GetCurrentState(ctx, summoner.PUUID)
 	if err != nil {
-		slog.Error("Failed to get current state", "summoner", summoner.RiotID, "error", err)
+		slog.ErrorContext(ctx, "Failed to get current state", "summoner", summoner.RiotID, "error", err)
 		return
 	}
 
-	if currentState == "" {
+	if currentState == nil {
 		return
 	}
 
+	// Skip if this is the first poll (no previous state recorded)
+	if stored == nil {
+		slog.InfoContext(ctx, "Setting initial state", "summoner", summoner.RiotID, "version", currentState.Version)
+		p.saveState(ctx, summoner, currentState)
+		return
+	}
+
+	previousState := &game.State{Version: stored.Version, Data: json.RawMessage(stored.Data)}
+
 	// Check if state has changed
-	if currentState == summoner.LastMatchID {
-		slog.Debug("No state change", "summoner", summoner.RiotID)
+	if previousState.Equal(currentState) {
+		slog.DebugContext(ctx, "No state change", "summoner", summoner.RiotID)
 		return
 	}
 
-	// Skip if this is the first poll (no previous state recorded)
-	if summoner.LastMatchID == "" {
-		slog.Info("Setting initial state", "summoner", summoner.RiotID, "state", currentState)
-		p.repo.UpdateSummonerLastMatch(summoner.ID, currentState)
+	events, err := tracker.CompareStates(previousState, currentState)
+	if err != nil {
+		// An unreadable previous state can't be diffed; replace it so polling recovers
+		slog.ErrorContext(ctx, "Failed to compare states", "summoner", summoner.RiotID, "error", err)
+		p.saveState(ctx, summoner, currentState)
 		return
 	}
 
-	slog.Info("State change detected", "summoner", summoner.RiotID, "newState", currentState)
+	if len(events) > 0 {
+		slog.InfoContext(ctx, "State change detected", "summoner", summoner.RiotID, "events", len(events))
+
+		change := game.StateChange{
+			Previous:   previousState,
+			Current:    currentState,
+			PreviousAt: stored.UpdatedAt,
+			Events:     events,
+		}
+
+		// Keep completed matches for recap reports; their highlights become events
+		if recorder, ok := tracker.(game.MatchRecorder); ok && change.HasEvent(game.EventMatchCompleted) {
+			change.Events = append(change.Events, p.recordMatches(ctx, summoner, recorder, change)...)
+		}
 
-	// Send notifications to all subscribed guilds
-	p.sendNotifications(ctx, summoner, tracker, currentState)
+		// Send notifications to all subscribed guilds
+		p.sendNotifications(ctx, summoner, tracker, change)
+	} else {
+		slog.DebugContext(ctx, "State changed without events", "summoner", summoner.RiotID)
+	}
 
 	// Update stored state
-	if err := p.repo.UpdateSummonerLastMatch(summoner.ID, currentState); err != nil {
-		slog.Error("Failed to update state", "error", err)
+	p.saveState(ctx, summoner, currentState)
+}
+
+// recordMatches stores the matches completed in a state change and returns their highlights
+func (p *Poller) recordMatches(ctx context.Context, summoner *storage.Summoner, recorder game.MatchRecorder, change game.StateChange) []game.Event {
+	results, err := recorder.MatchResults(ctx, summoner.PUUID, change)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get match results", "summoner", summoner.RiotID, "error", err)
+		return nil
+	}
+
+	var highlights []game.Event
+	for _, m := range results {
+		highlights = append(highlights, m.Highlights...)
+
+		err := p.repo.InsertMatchResult(&storage.MatchResult{
+			SummonerID: summoner.ID,
+			MatchID:    m.MatchID,
+			Win:        m.Win,
+			Kills:      m.Kills,
+			Deaths:     m.Deaths,
+			Assists:    m.Assists,
+			Character:  m.Character,
+			PlayedAt:   m.PlayedAt,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to record match", "summoner", summoner.RiotID, "match", m.MatchID, "error", err)
+		}
+	}
+	return highlights
+}
+
+// saveState persists the current tracker state of a player
+func (p *Poller) saveState(ctx context.Context, summoner *storage.Summoner, state *game.State) {
+	err := p.repo.UpsertPlayerState(&storage.PlayerState{
+		SummonerID: summoner.ID,
+		Version:    state.Version,
+		Data:       string(state.Data),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update state", "summoner", summoner.RiotID, "error", err)
 	}
 }
 
-// sendNotifications sends notifications to all subscribed guilds
-func (p *Poller) sendNotifications(ctx context.Context, summoner *storage.Summoner, tracker game.Tracker, stateID string) {
+// sendNotifications sends notifications to all subscribed guilds and users
+func (p *Poller) sendNotifications(ctx context.Context, summoner *storage.Summoner, tracker game.Tracker, change game.StateChange) {
 	subs, err := p.repo.GetSubscriptionsBySummoner(summoner.ID)
 	if err != nil {
-		slog.Error("Failed to get subscriptions", "error", err)
+		slog.ErrorContext(ctx, "Failed to get subscriptions", "error", err)
 		return
 	}
 
 	for _, sub := range subs {
-		settings, err := p.repo.GetGuildSettings(sub.GuildID)
-		if err != nil {
-			slog.Warn("No notification channel set for guild", "guildID", sub.GuildID)
+		if sub.Muted {
+			slog.DebugContext(ctx, "Notifications muted", "summoner", summoner.RiotID, "target", sub.TargetID)
 			continue
 		}
 
-		// Create notification using the unified interface
-		embed, err := tracker.CreateNotification(ctx, summoner.PUUID, summoner.RiotID, stateID)
+		targets, locale, ok := p.deliveryFor(ctx, sub, tracker.Type())
+		if !ok {
+			continue
+		}
+
+		// Create notification using the unified interface, in the target's language
+		localeCtx := i18n.WithLocale(ctx, locale)
+		embed, err := tracker.CreateNotification(localeCtx, summoner.PUUID, summoner.RiotID, change)
 		if err != nil {
-			slog.Error("Failed to create notification", "summoner", summoner.RiotID, "error", err)
+			slog.ErrorContext(ctx, "Failed to create notification", "summoner", summoner.RiotID, "error", err)
+			continue
+		}
+		if embed == nil {
+			slog.DebugContext(ctx, "Tracker skipped notification", "summoner", summoner.RiotID, "state", change.Current)
 			continue
 		}
 
-		_, err = p.discord.ChannelMessageSendEmbed(settings.NotificationChannelID, embed)
+		var components []discordgo.MessageComponent
+		if p.components != nil {
+			components = p.components(sub.TargetID, locale, summoner, tracker, change)
+		}
+
+		msg := &notify.Message{
+			GuildID:    sub.TargetID,
+			GameType:   tracker.Type(),
+			GameName:   tracker.Name(),
+			PlayerID:   summoner.PUUID,
+			PlayerName: summoner.RiotID,
+			Events:     change.Events,
+			Embed:      embed,
+			Locale:     locale,
+			Components: components,
+		}
+		if sub.TargetType == storage.TargetGuild {
+			// Mentions are resolved first so held back notifications still ping in their digest
+			p.addMentions(ctx, sub.TargetID, summoner, tracker, change, msg)
+			if p.holdBack(ctx, sub.TargetID, msg) {
+				continue
+			}
+		}
+
+		err = p.notifier.Send(ctx, targets, msg)
 		if err != nil {
-			slog.Error("Failed to send notification", "guildID", sub.GuildID, "error", err)
+			slog.ErrorContext(ctx, "Failed to send notification", "target", sub.TargetID, "error", err)
 		} else {
-			slog.Info("Sent notification", "summoner", summoner.RiotID, "guildID", sub.GuildID)
+			slog.InfoContext(ctx, "Sent notification", "summoner", summoner.RiotID, "target", sub.TargetID, "targets", len(targets))
+		}
+	}
+}
+
+// deliveryFor returns where and in which language a subscription's
+// notifications are sent; ok is false when they can't be sent right now
+// Personal subscriptions go to the user's DMs outside their quiet hours
+func (p *Poller) deliveryFor(ctx context.Context, sub *storage.Subscription, gameType game.GameType) ([]notify.Target, i18n.Locale, bool) {
+	if sub.TargetType == storage.TargetUser {
+		settings, err := p.repo.GetUserSettings(sub.TargetID)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to get user settings", "userID", sub.TargetID, "error", err)
+			return nil, i18n.Default, false
+		}
+		if settings.QuietHours.Active(time.Now()) {
+			slog.DebugContext(ctx, "Skipping DM during quiet hours", "userID", sub.TargetID)
+			return nil, i18n.Default, false
+		}
+		locale, ok := i18n.Parse(settings.Language)
+		if !ok {
+			locale = i18n.Default
 		}
+		return []notify.Target{{Kind: notify.KindDM, Address: sub.TargetID}}, locale, true
+	}
+
+	targets, err := p.targetsFor(sub.TargetID, gameType)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get notification routes", "guildID", sub.TargetID, "error", err)
+		return nil, i18n.Default, false
+	}
+	if len(targets) == 0 {
+		slog.WarnContext(ctx, "No notification channel set for guild", "guildID", sub.TargetID)
+		return nil, i18n.Default, false
+	}
+	return targets, p.localeFor(sub.TargetID), true
+}
+
+// localeFor returns the language notifications are written in for a guild
+func (p *Poller) localeFor(guildID string) i18n.Locale {
+	settings, err := p.repo.GetGuildSettings(guildID)
+	if err != nil {
+		return i18n.Default
+	}
+	if l, ok := i18n.Parse(settings.Language); ok {
+		return l
+	}
+	return i18n.Default
+}
+
+// targetsFor returns where a guild's notifications for a game are delivered
+// Routes matching the game are used; otherwise the guild's notification channel
+func (p *Poller) targetsFor(guildID string, gameType game.GameType) ([]notify.Target, error) {
+	routes, err := p.repo.GetNotificationRoutes(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []notify.Target
+	for _, route := range routes {
+		if route.GameType != "" && route.GameType != string(gameType) {
+			continue
+		}
+		targets = append(targets, notify.Target{
+			Kind:      notify.Kind(route.Sink),
+			Address:   route.Target,
+			Username:  route.Username,
+			AvatarURL: route.AvatarURL,
+		})
+	}
+	if len(targets) > 0 {
+		return targets, nil
+	}
+
+	settings, err := p.repo.GetGuildSettings(guildID)
+	if err != nil || settings.NotificationChannelID == "" {
+		return nil, nil
 	}
+	return []notify.Target{{Kind: notify.KindChannel, Address: settings.NotificationChannelID}}, nil
 }
@@ -0,0 +1,381 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/notify"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+const testGuild = "guild-1"
+
+// fakeTracker reports a change for every state and renders an embed naming
+// the player and the locale it was rendered in
+type fakeTracker struct {
+	gameType game.GameType
+}
+
+func (t *fakeTracker) Name() string                        { return string(t.gameType) }
+func (t *fakeTracker) Type() game.GameType                 { return t.gameType }
+func (t *fakeTracker) Description() string                 { return "" }
+func (t *fakeTracker) ValidatePlayerID(input string) error { return nil }
+
+func (t *fakeTracker) ResolvePlayer(ctx context.Context, input string) (*game.PlayerInfo, error) {
+	return &game.PlayerInfo{ID: input, DisplayName: input, GameType: t.gameType}, nil
+}
+
+func (t *fakeTracker) GetCurrentState(ctx context.Context, playerID string) (*game.State, error) {
+	return game.NewState(1, "latest")
+}
+
+func (t *fakeTracker) CompareStates(prev, cur *game.State) ([]game.Event, error) {
+	return []game.Event{{Type: game.EventMatchCompleted, Summary: "latest"}}, nil
+}
+
+func (t *fakeTracker) CreateNotification(ctx context.Context, playerID, playerName string, change game.StateChange) (*discordgo.MessageEmbed, error) {
+	return &discordgo.MessageEmbed{Title: playerName, Description: string(i18n.FromContext(ctx))}, nil
+}
+
+// testPoller is a poller over a temporary database whose deliveries are recorded
+type testPoller struct {
+	*Poller
+	repo    *storage.Repository
+	channel *notify.Recorder
+	webhook *notify.Recorder
+	slack   *notify.Recorder
+	dm      *notify.Recorder
+}
+
+func newTestPoller(t *testing.T) *testPoller {
+	t.Helper()
+	repo, err := storage.NewRepository(filepath.Join(t.TempDir(), "bot.db"))
+	if err != nil {
+		t.Fatalf("NewRepository: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+
+	tp := &testPoller{
+		repo:    repo,
+		channel: notify.NewRecorder(notify.KindChannel),
+		webhook: notify.NewRecorder(notify.KindWebhook),
+		slack:   notify.NewRecorder(notify.KindSlack),
+		dm:      notify.NewRecorder(notify.KindDM),
+	}
+	tp.Poller = New(repo, game.NewRegistry(), notify.NewDispatcher(tp.channel, tp.webhook, tp.slack, tp.dm), 60)
+	return tp
+}
+
+// subscribe stores a summoner of the game followed by the test guild
+func (tp *testPoller) subscribe(t *testing.T, gameType game.GameType, name string) *storage.Summoner {
+	t.Helper()
+	return tp.subscribeTarget(t, gameType, name, storage.TargetGuild, testGuild)
+}
+
+// subscribeTarget stores a summoner of the game followed by a guild or user
+func (tp *testPoller) subscribeTarget(t *testing.T, gameType game.GameType, name string, targetType storage.TargetType, targetID string) *storage.Summoner {
+	t.Helper()
+	summoner := &storage.Summoner{PUUID: name, RiotID: name, GameType: string(gameType), Region: "KR"}
+	if err := tp.repo.CreateSummoner(summoner); err != nil {
+		t.Fatalf("CreateSummoner: %v", err)
+	}
+	sub := &storage.Subscription{SummonerID: summoner.ID, TargetType: targetType, TargetID: targetID}
+	if err := tp.repo.CreateSubscription(sub); err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	return summoner
+}
+
+func (tp *testPoller) addRoute(t *testing.T, gameType game.GameType, sink notify.Kind, target string) {
+	t.Helper()
+	route := &storage.NotificationRoute{GuildID: testGuild, GameType: string(gameType), Sink: string(sink), Target: target}
+	if err := tp.repo.CreateNotificationRoute(route); err != nil {
+		t.Fatalf("CreateNotificationRoute: %v", err)
+	}
+}
+
+// notifyChange delivers a match notification for a summoner
+func (tp *testPoller) notifyChange(t *testing.T, summoner *storage.Summoner, tracker game.Tracker) {
+	t.Helper()
+	state, err := tracker.GetCurrentState(context.Background(), summoner.PUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, _ := tracker.CompareStates(nil, state)
+	tp.sendNotifications(context.Background(), summoner, tracker, game.StateChange{Current: state, Events: events})
+}
+
+func TestRoutesSelectedByGame(t *testing.T) {
+	tp := newTestPoller(t)
+	if err := tp.repo.UpsertGuildSettings(&storage.GuildSettings{GuildID: testGuild, NotificationChannelID: "general"}); err != nil {
+		t.Fatal(err)
+	}
+	tp.addRoute(t, game.GameTypeLoL, notify.KindChannel, "lol-channel")
+	tp.addRoute(t, "", notify.KindWebhook, "https://example.com/all-games")
+	tp.addRoute(t, game.GameTypeValorant, notify.KindSlack, "https://hooks.slack.com/services/valorant")
+
+	lol := &fakeTracker{gameType: game.GameTypeLoL}
+	tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "faker"), lol)
+
+	if got := tp.channel.Deliveries(); len(got) != 1 || got[0].Target.Address != "lol-channel" {
+		t.Errorf("channel deliveries = %+v, want only the LoL route", got)
+	}
+	if got := tp.webhook.Deliveries(); len(got) != 1 || got[0].Message.PlayerName != "faker" {
+		t.Errorf("webhook deliveries = %+v, want the all-games route", got)
+	}
+	if got := tp.slack.Deliveries(); len(got) != 0 {
+		t.Errorf("slack deliveries = %+v, want none for a Valorant-only route", got)
+	}
+}
+
+func TestFallbackToNotificationChannel(t *testing.T) {
+	tp := newTestPoller(t)
+	if err := tp.repo.UpsertGuildSettings(&storage.GuildSettings{GuildID: testGuild, NotificationChannelID: "general"}); err != nil {
+		t.Fatal(err)
+	}
+	tp.addRoute(t, game.GameTypeValorant, notify.KindSlack, "https://hooks.slack.com/services/valorant")
+
+	tft := &fakeTracker{gameType: game.GameTypeTFT}
+	tp.notifyChange(t, tp.subscribe(t, game.GameTypeTFT, "faker"), tft)
+
+	if got := tp.channel.Deliveries(); len(got) != 1 || got[0].Target.Address != "general" {
+		t.Errorf("channel deliveries = %+v, want the /채널설정 channel", got)
+	}
+	if got := tp.slack.Deliveries(); len(got) != 0 {
+		t.Errorf("slack deliveries = %+v, want none", got)
+	}
+}
+
+func TestNoChannelSkipsGuild(t *testing.T) {
+	tp := newTestPoller(t)
+
+	lol := &fakeTracker{gameType: game.GameTypeLoL}
+	tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "faker"), lol)
+
+	if got := tp.channel.Deliveries(); len(got) != 0 {
+		t.Errorf("channel deliveries = %+v, want none without a channel or route", got)
+	}
+}
+
+func TestFailingSinkDoesNotStopOthers(t *testing.T) {
+	tp := newTestPoller(t)
+	tp.addRoute(t, "", notify.KindSlack, "https://hooks.slack.com/services/broken")
+	tp.addRoute(t, "", notify.KindChannel, "lol-channel")
+	tp.slack.SetError(errors.New("slack is down"))
+
+	lol := &fakeTracker{gameType: game.GameTypeLoL}
+	tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "faker"), lol)
+	tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "chovy"), lol)
+
+	got := tp.channel.Deliveries()
+	if len(got) != 2 || got[0].Message.PlayerName != "faker" || got[1].Message.PlayerName != "chovy" {
+		t.Errorf("channel deliveries = %+v, want both notifications despite the failing sink", got)
+	}
+}
+
+func TestPersonalNotificationUsesUserLanguage(t *testing.T) {
+	tp := newTestPoller(t)
+	if err := tp.repo.SetUserLanguage("user-1", string(i18n.English)); err != nil {
+		t.Fatal(err)
+	}
+
+	tp.SetComponentBuilder(func(guildID string, locale i18n.Locale, summoner *storage.Summoner, tracker game.Tracker, change game.StateChange) []discordgo.MessageComponent {
+		return []discordgo.MessageComponent{discordgo.Button{Label: locale.T("button.mute")}}
+	})
+
+	lol := &fakeTracker{gameType: game.GameTypeLoL}
+	tp.notifyChange(t, tp.subscribeTarget(t, game.GameTypeLoL, "faker", storage.TargetUser, "user-1"), lol)
+	tp.notifyChange(t, tp.subscribeTarget(t, game.GameTypeLoL, "chovy", storage.TargetUser, "user-2"), lol)
+
+	got := tp.dm.Deliveries()
+	if len(got) != 2 {
+		t.Fatalf("dm deliveries = %+v, want one per user", got)
+	}
+	if got[0].Target.Address != "user-1" || got[0].Message.Embed.Description != string(i18n.English) || got[0].Message.Locale != i18n.English {
+		t.Errorf("delivery = %+v, want the user's language", got[0])
+	}
+	if got[1].Target.Address != "user-2" || got[1].Message.Embed.Description != string(i18n.Default) {
+		t.Errorf("delivery = %+v, want the default language without a saved one", got[1])
+	}
+
+	// Buttons are labelled in the same language as the embed
+	for i, want := range []i18n.Locale{i18n.English, i18n.Default} {
+		components := got[i].Message.Components
+		if len(components) != 1 {
+			t.Fatalf("components = %+v, want one button", components)
+		}
+		if button := components[0].(discordgo.Button); button.Label != want.T("button.mute") {
+			t.Errorf("button label = %q, want %q", button.Label, want.T("button.mute"))
+		}
+	}
+}
+
+func TestHeldBackNotificationsKeepMentions(t *testing.T) {
+	lol := &fakeTracker{gameType: game.GameTypeLoL}
+	allDay := storage.QuietHours{Start: 0, End: 24, Timezone: "UTC"}
+
+	tests := []struct {
+		name  string
+		setup func(tp *testPoller) error
+		flush func(tp *testPoller) error
+		want  int // deliveries, the last of which is the digest
+	}{
+		{
+			name:  "quiet hours digest",
+			setup: func(tp *testPoller) error { return tp.repo.SetGuildQuietHours(testGuild, allDay, storage.QuietDigest) },
+			flush: func(tp *testPoller) error {
+				if err := tp.repo.SetGuildQuietHours(testGuild, storage.QuietHours{}, storage.QuietDigest); err != nil {
+					return err
+				}
+				tp.flushDigests(context.Background())
+				return nil
+			},
+			want: 1,
+		},
+		{
+			name:  "burst summary",
+			setup: func(tp *testPoller) error { return tp.repo.SetGuildBurstLimit(testGuild, 1) },
+			flush: func(tp *testPoller) error {
+				tp.flushCollapsed(context.Background())
+				return nil
+			},
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestPoller(t)
+			if err := tp.repo.UpsertGuildSettings(&storage.GuildSettings{GuildID: testGuild, NotificationChannelID: "general"}); err != nil {
+				t.Fatal(err)
+			}
+			rule := &storage.MentionRule{GuildID: testGuild, Event: string(game.EventMatchCompleted), MentionType: storage.MentionRole, MentionID: "role-1"}
+			if err := tp.repo.CreateMentionRule(rule); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.setup(tp); err != nil {
+				t.Fatal(err)
+			}
+
+			tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "faker"), lol)
+			tp.notifyChange(t, tp.subscribe(t, game.GameTypeLoL, "chovy"), lol)
+			if err := tt.flush(tp); err != nil {
+				t.Fatal(err)
+			}
+
+			got := tp.channel.Deliveries()
+			if len(got) != tt.want {
+				t.Fatalf("deliveries = %d, want %d", len(got), tt.want)
+			}
+			if roles := got[len(got)-1].Message.MentionRoles; len(roles) != 1 || roles[0] != "role-1" {
+				t.Errorf("digest mention roles = %v, want the rule's role", roles)
+			}
+		})
+	}
+}
+
+// rankedTracker is a fakeTracker whose states are standings
+type rankedTracker struct {
+	fakeTracker
+}
+
+func (t *rankedTracker) StateStanding(state *game.State) (*game.Standing, error) {
+	var s game.Standing
+	if err := state.Decode(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func TestAddMentions(t *testing.T) {
+	standing := func(tier, division string) *game.State {
+		state, err := game.NewState(1, game.Standing{Tier: tier, Division: division})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return state
+	}
+	gold, platinum := standing("GOLD", "I"), standing("PLATINUM", "IV")
+	rankChanged := []game.Event{{Type: game.EventRankChanged}}
+	matchCompleted := []game.Event{{Type: game.EventMatchCompleted}}
+
+	tests := []struct {
+		name      string
+		rule      storage.MentionRule
+		linked    bool
+		change    game.StateChange
+		wantUsers []string
+		wantRoles []string
+	}{
+		{
+			name:      "role on an event",
+			rule:      storage.MentionRule{Event: string(game.EventMatchCompleted), MentionType: storage.MentionRole, MentionID: "role-1"},
+			change:    game.StateChange{Current: gold, Events: matchCompleted},
+			wantRoles: []string{"role-1"},
+		},
+		{
+			name:   "event not in the change",
+			rule:   storage.MentionRule{Event: string(game.EventRankChanged), MentionType: storage.MentionRole, MentionID: "role-1"},
+			change: game.StateChange{Current: gold, Events: matchCompleted},
+		},
+		{
+			name:   "rule for another game",
+			rule:   storage.MentionRule{GameType: string(game.GameTypeValorant), Event: string(game.EventMatchCompleted), MentionType: storage.MentionRole, MentionID: "role-1"},
+			change: game.StateChange{Current: gold, Events: matchCompleted},
+		},
+		{
+			name:      "owner on promotion",
+			rule:      storage.MentionRule{Event: storage.MentionPromotion, MentionType: storage.MentionOwner},
+			linked:    true,
+			change:    game.StateChange{Previous: gold, Current: platinum, Events: rankChanged},
+			wantUsers: []string{"owner-1"},
+		},
+		{
+			name:   "owner on demotion",
+			rule:   storage.MentionRule{Event: storage.MentionPromotion, MentionType: storage.MentionOwner},
+			linked: true,
+			change: game.StateChange{Previous: platinum, Current: gold, Events: rankChanged},
+		},
+		{
+			name:   "owner without a linked user",
+			rule:   storage.MentionRule{Event: storage.MentionPromotion, MentionType: storage.MentionOwner},
+			change: game.StateChange{Previous: gold, Current: platinum, Events: rankChanged},
+		},
+		{
+			name:      "specific user",
+			rule:      storage.MentionRule{Event: string(game.EventRankChanged), MentionType: storage.MentionUser, MentionID: "user-9"},
+			change:    game.StateChange{Previous: platinum, Current: gold, Events: rankChanged},
+			wantUsers: []string{"user-9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestPoller(t)
+			tracker := &rankedTracker{fakeTracker{gameType: game.GameTypeLoL}}
+			summoner := tp.subscribe(t, game.GameTypeLoL, "faker")
+			if tt.linked {
+				if err := tp.repo.LinkPlayer(&storage.PlayerLink{SummonerID: summoner.ID, UserID: "owner-1"}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			rule := tt.rule
+			rule.GuildID = testGuild
+			if err := tp.repo.CreateMentionRule(&rule); err != nil {
+				t.Fatal(err)
+			}
+
+			msg := &notify.Message{}
+			tp.addMentions(context.Background(), testGuild, summoner, tracker, tt.change, msg)
+			if !slices.Equal(msg.MentionUsers, tt.wantUsers) || !slices.Equal(msg.MentionRoles, tt.wantRoles) {
+				t.Errorf("mentions = users %v roles %v, want users %v roles %v", msg.MentionUsers, msg.MentionRoles, tt.wantUsers, tt.wantRoles)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/flor3z/discord-bot/internal/game"
+	"github.com/flor3z/discord-bot/internal/i18n"
+	"github.com/flor3z/discord-bot/internal/nexon"
+	"github.com/flor3z/discord-bot/internal/storage"
+)
+
+// Period is the span a recap covers
+type Period string
+
+const (
+	PeriodWeekly  Period = "weekly"
+	PeriodMonthly Period = "monthly"
+)
+
+// maxPlayerFields caps the players listed in one recap embed
+const maxPlayerFields = 20
+
+// PlayerStats is one tracked player's activity within a recap period
+type PlayerStats struct {
+	Summoner *storage.Summoner
+	GameName string
+
+	// Match-based games
+	Matches    []*storage.MatchResult
+	Wins       int
+	Best       *storage.MatchResult // highest KDA
+	Worst      *storage.MatchResult // lowest KDA
+	MostPlayed string
+	MostCount  int
+
+	// Progression games (MapleStory)
+	StartLevel   int64
+	EndLevel     int64
+	LevelsGained float64 // including EXP progress within the level
+}
+
+// WinRate returns the share of matches won (0-1)
+func (p *PlayerStats) WinRate() float64 {
+	if len(p.Matches) == 0 {
+		return 0
+	}
+	return float64(p.Wins) / float64(len(p.Matches))
+}
+
+// active reports whether the player did anything during the period
+func (p *PlayerStats) active() bool {
+	return len(p.Matches) > 0 || p.LevelsGained > 0
+}
+
+// Report is a guild's recap over a period
+type Report struct {
+	Period  Period
+	From    time.Time // inclusive
+	To      time.Time // exclusive
+	Players []*PlayerStats
+	MVP     *PlayerStats
+	Idle    int // tracked players with no activity
+}
+
+// Build collects the recap of the players tracked in a guild over [from, to)
+func Build(repo *storage.Repository, registry *game.Registry, guildID string, period Period, from, to time.Time) (*Report, error) {
+	summoners, err := repo.GetSummonersByGuild(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	r := &Report{Period: period, From: from, To: to}
+	for _, summoner := range summoners {
+		stats := &PlayerStats{Summoner: summoner, GameName: summoner.GameType}
+		if tracker, err := registry.Get(game.GameType(summoner.GameType)); err == nil {
+			stats.GameName = tracker.Name()
+		}
+
+		matches, err := repo.GetMatchResults(summoner.ID, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get matches of %s: %w", summoner.RiotID, err)
+		}
+		addMatches(stats, matches)
+
+		if summoner.GameType == string(game.GameTypeMaplestory) {
+			if err := addProgress(repo, stats, from, to); err != nil {
+				return nil, err
+			}
+		}
+
+		if stats.active() {
+			r.Players = append(r.Players, stats)
+		} else {
+			r.Idle++
+		}
+	}
+
+	sort.SliceStable(r.Players, func(i, j int) bool {
+		return score(r.Players[i]) > score(r.Players[j])
+	})
+	if len(r.Players) > 0 {
+		r.MVP = r.Players[0]
+	}
+	return r, nil
+}
+
+// addMatches fills the match statistics of a player
+func addMatches(stats *PlayerStats, matches []*storage.MatchResult) {
+	stats.Matches = matches
+	counts := make(map[string]int)
+	for _, m := range matches {
+		if m.Win {
+			stats.Wins++
+		}
+		if stats.Best == nil || m.KDA() > stats.Best.KDA() {
+			stats.Best = m
+		}
+		if stats.Worst == nil || m.KDA() < stats.Worst.KDA() {
+			stats.Worst = m
+		}
+		counts[m.Character]++
+		if c := counts[m.Character]; c > stats.MostCount {
+			stats.MostPlayed, stats.MostCount = m.Character, c
+		}
+	}
+}
+
+// addProgress fills the levels gained from daily character snapshots
+// The snapshot of the day before the period is used as the starting point
+func addProgress(repo *storage.Repository, stats *PlayerStats, from, to time.Time) error {
+	start := nexon.FormatDate(from.In(nexon.KST).AddDate(0, 0, -1))
+	end := nexon.FormatDate(to.In(nexon.KST).Add(-time.Second))
+	snaps, err := repo.GetCharacterSnapshots(stats.Summoner.ID, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshots of %s: %w", stats.Summoner.RiotID, err)
+	}
+	if len(snaps) < 2 {
+		return nil
+	}
+
+	first, last := snaps[0], snaps[len(snaps)-1]
+	stats.StartLevel = first.Level
+	stats.EndLevel = last.Level
+	stats.LevelsGained = max(last.Progress()-first.Progress(), 0)
+	return nil
+}
+
+// score ranks players for the player of the period:
+// a win counts 3 (played + won), a loss 1 and a MapleStory level 6 (two wins)
+func score(p *PlayerStats) float64 {
+	return float64(p.Wins)*2 + float64(len(p.Matches)) + p.LevelsGained*6
+}
+
+// Embed renders the report as a Discord embed in a locale
+func (r *Report) Embed(l i18n.Locale) *discordgo.MessageEmbed {
+	last := r.To.Add(-time.Second)
+	titleKey, mvpKey := "report.title_weekly", "report.mvp_weekly"
+	if r.Period == PeriodMonthly {
+		titleKey, mvpKey = "report.title_monthly", "report.mvp_monthly"
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: l.T(titleKey, r.From.Format("01/02"), last.Format("01/02")),
+		Color: 0x5865F2,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: l.T("report.footer"),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	if len(r.Players) == 0 {
+		embed.Description = l.T("report.no_activity")
+		return embed
+	}
+
+	embed.Description = fmt.Sprintf("🏆 **%s**: %s (%s)\n%s",
+		l.T(mvpKey), r.MVP.Summoner.RiotID, r.MVP.GameName, summaryLine(l, r.MVP))
+
+	for idx, p := range r.Players {
+		if idx == maxPlayerFields {
+			embed.Footer.Text = l.T("report.more_players", len(r.Players)-maxPlayerFields) + " · " + embed.Footer.Text
+			break
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s · %s", p.Summoner.RiotID, p.GameName),
+			Value: playerLines(l, p),
+		})
+	}
+
+	if r.Idle > 0 {
+		embed.Footer.Text = l.T("report.idle_players", r.Idle) + " · " + embed.Footer.Text
+	}
+	return embed
+}
+
+// summaryLine is a one-line summary of a player's period
+func summaryLine(l i18n.Locale, p *PlayerStats) string {
+	var parts []string
+	if n := len(p.Matches); n > 0 {
+		parts = append(parts, l.T("report.record_short", n, p.Wins, p.WinRate()*100))
+	}
+	if p.LevelsGained > 0 {
+		parts = append(parts, l.T("report.levels_gained", p.LevelsGained))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// playerLines describes a player's period in detail
+func playerLines(l i18n.Locale, p *PlayerStats) string {
+	var lines []string
+	if n := len(p.Matches); n > 0 {
+		lines = append(lines, l.T("report.record", n, p.Wins, n-p.Wins, p.WinRate()*100))
+		lines = append(lines, l.T("report.best", matchLine(l, p.Best)))
+		if n > 1 {
+			lines = append(lines, l.T("report.worst", matchLine(l, p.Worst)))
+		}
+		if p.MostPlayed != "" {
+			lines = append(lines, l.T("report.most_played", p.MostPlayed, p.MostCount))
+		}
+	}
+	if p.LevelsGained > 0 {
+		lines = append(lines, fmt.Sprintf("Lv.%d → Lv.%d (+%.2f)", p.StartLevel, p.EndLevel, p.LevelsGained))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// matchLine formats one match as character and K/D/A
+func matchLine(l i18n.Locale, m *storage.MatchResult) string {
+	result := l.T("report.loss")
+	if m.Win {
+		result = l.T("report.win")
+	}
+	return fmt.Sprintf("%s %d/%d/%d (%.2f, %s)", m.Character, m.Kills, m.Deaths, m.Assists, m.KDA(), result)
+}
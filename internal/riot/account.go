@@ -21,10 +21,10 @@ func (c *Client) GetAccountByRiotID(ctx context.Context, gameName, tagLine strin
 	encodedTagLine := url.PathEscape(tagLine)
 
 	endpoint := fmt.Sprintf("%s/riot/account/v1/accounts/by-riot-id/%s/%s",
-		RegionalBaseURL, encodedGameName, encodedTagLine)
+		c.regionalURL, encodedGameName, encodedTagLine)
 
 	var account Account
-	if err := c.get(endpoint, &account); err != nil {
+	if err := c.get(ctx, endpoint, &account); err != nil {
 		return nil, fmt.Errorf("failed to get account by Riot ID: %w", err)
 	}
 
@@ -34,10 +34,10 @@ func (c *Client) GetAccountByRiotID(ctx context.Context, gameName, tagLine strin
 // GetAccountByPUUID retrieves account information by PUUID
 func (c *Client) GetAccountByPUUID(ctx context.Context, puuid string) (*Account, error) {
 	endpoint := fmt.Sprintf("%s/riot/account/v1/accounts/by-puuid/%s",
-		RegionalBaseURL, puuid)
+		c.regionalURL, puuid)
 
 	var account Account
-	if err := c.get(endpoint, &account); err != nil {
+	if err := c.get(ctx, endpoint, &account); err != nil {
 		return nil, fmt.Errorf("failed to get account by PUUID: %w", err)
 	}
 
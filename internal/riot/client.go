@@ -1,9 +1,12 @@
 package riot
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"sync"
 	"time"
@@ -12,13 +15,23 @@ import (
 const (
 	// Regional routing values for Asia (Korea)
 	RegionalBaseURL = "https://asia.api.riotgames.com"
+
+	// Platform routing values for Korea
+	PlatformBaseURL = "https://kr.api.riotgames.com"
 )
 
+// ErrNotFound is wrapped by errors for requests the API answered with 404
+var ErrNotFound = errors.New("not found")
+
 // Client is a Riot Games API client with rate limiting
 type Client struct {
 	apiKey     string
 	httpClient *http.Client
 
+	// Base URLs for regional and platform routing
+	regionalURL string
+	platformURL string
+
 	// Simple rate limiter
 	mu          sync.Mutex
 	lastRequest time.Time
@@ -27,11 +40,38 @@ type Client struct {
 
 // NewClient creates a new Riot API client
 func NewClient(apiKey string) *Client {
+	return NewClientWithBaseURLs(apiKey, RegionalBaseURL, PlatformBaseURL)
+}
+
+var (
+	sharedMu      sync.Mutex
+	sharedClients = make(map[string]*Client)
+)
+
+// SharedClient returns the client for an API key, creating it on first use
+// Every tracker using the same key shares its rate limiter
+func SharedClient(apiKey string) *Client {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	client, ok := sharedClients[apiKey]
+	if !ok {
+		client = NewClient(apiKey)
+		sharedClients[apiKey] = client
+	}
+	return client
+}
+
+// NewClientWithBaseURLs creates a Riot API client that talks to the given
+// regional and platform hosts, e.g. a local fake server during development
+func NewClientWithBaseURLs(apiKey, regionalURL, platformURL string) *Client {
 	return &Client{
 		apiKey: apiKey,
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		regionalURL: regionalURL,
+		platformURL: platformURL,
 		// Rate limit: ~20 requests per second (50ms between requests)
 		minInterval: 50 * time.Millisecond,
 	}
@@ -68,18 +108,26 @@ func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
 }
 
 // get performs a GET request and decodes the JSON response
-func (c *Client) get(url string, result interface{}) error {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+// Requests are bound to ctx and logged with its correlation ID
+func (c *Client) get(ctx context.Context, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
+	start := time.Now()
 	resp, err := c.doRequest(req)
 	if err != nil {
+		slog.DebugContext(ctx, "Riot API request failed", "path", req.URL.Path, "error", err)
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	slog.DebugContext(ctx, "Riot API request", "path", req.URL.Path, "status", resp.StatusCode, "duration", time.Since(start))
 
+	if resp.StatusCode == http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: status %d, body: %s: %w", resp.StatusCode, string(body), ErrNotFound)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(body))
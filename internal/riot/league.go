@@ -0,0 +1,21 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+)
+
+// SoloQueue is the queue type of ranked solo/duo league entries
+const SoloQueue = "RANKED_SOLO_5x5"
+
+// GetLeagueEntries retrieves a player's ranked LoL entries (League-V4)
+func (c *Client) GetLeagueEntries(ctx context.Context, puuid string) ([]LeagueEntry, error) {
+	endpoint := fmt.Sprintf("%s/lol/league/v4/entries/by-puuid/%s", c.platformURL, puuid)
+
+	var entries []LeagueEntry
+	if err := c.get(ctx, endpoint, &entries); err != nil {
+		return nil, fmt.Errorf("failed to get league entries: %w", err)
+	}
+
+	return entries, nil
+}
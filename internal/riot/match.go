@@ -41,6 +41,7 @@ type Participant struct {
 	Kills                     int    `json:"kills"`
 	Deaths                    int    `json:"deaths"`
 	Assists                   int    `json:"assists"`
+	PentaKills                int    `json:"pentaKills"`
 	TotalMinionsKilled        int    `json:"totalMinionsKilled"`
 	NeutralMinionsKilled      int    `json:"neutralMinionsKilled"`
 	GoldEarned                int    `json:"goldEarned"`
@@ -68,10 +69,10 @@ func (c *Client) GetMatchIDsByPUUID(ctx context.Context, puuid string, count int
 	}
 
 	endpoint := fmt.Sprintf("%s/lol/match/v5/matches/by-puuid/%s/ids?count=%d",
-		RegionalBaseURL, puuid, count)
+		c.regionalURL, puuid, count)
 
 	var matchIDs []string
-	if err := c.get(endpoint, &matchIDs); err != nil {
+	if err := c.get(ctx, endpoint, &matchIDs); err != nil {
 		return nil, fmt.Errorf("failed to get match IDs: %w", err)
 	}
 
@@ -80,10 +81,10 @@ func (c *Client) GetMatchIDsByPUUID(ctx context.Context, puuid string, count int
 
 // GetMatch retrieves detailed match information
 func (c *Client) GetMatch(ctx context.Context, matchID string) (*Match, error) {
-	endpoint := fmt.Sprintf("%s/lol/match/v5/matches/%s", RegionalBaseURL, matchID)
+	endpoint := fmt.Sprintf("%s/lol/match/v5/matches/%s", c.regionalURL, matchID)
 
 	var match Match
-	if err := c.get(endpoint, &match); err != nil {
+	if err := c.get(ctx, endpoint, &match); err != nil {
 		return nil, fmt.Errorf("failed to get match: %w", err)
 	}
 
@@ -0,0 +1,50 @@
+package riot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CurrentGame is a LoL game in progress from the Spectator-V5 API
+type CurrentGame struct {
+	GameID            int64                    `json:"gameId"`
+	GameMode          string                   `json:"gameMode"`
+	GameQueueConfigID int                      `json:"gameQueueConfigId"`
+	GameStartTime     int64                    `json:"gameStartTime"` // Unix ms, 0 while loading
+	Participants      []CurrentGameParticipant `json:"participants"`
+}
+
+// CurrentGameParticipant is a player in a game in progress
+type CurrentGameParticipant struct {
+	PUUID      string `json:"puuid"`
+	RiotID     string `json:"riotId"`
+	ChampionID int    `json:"championId"`
+	TeamID     int    `json:"teamId"`
+}
+
+// FindParticipant returns the participant with the given PUUID, or nil
+func (g *CurrentGame) FindParticipant(puuid string) *CurrentGameParticipant {
+	for i := range g.Participants {
+		if g.Participants[i].PUUID == puuid {
+			return &g.Participants[i]
+		}
+	}
+	return nil
+}
+
+// GetCurrentGame retrieves the LoL game a player is in (Spectator-V5)
+// Returns nil if the player is not in a game
+func (c *Client) GetCurrentGame(ctx context.Context, puuid string) (*CurrentGame, error) {
+	endpoint := fmt.Sprintf("%s/lol/spectator/v5/active-games/by-summoner/%s", c.platformURL, puuid)
+
+	var g CurrentGame
+	if err := c.get(ctx, endpoint, &g); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get current game: %w", err)
+	}
+
+	return &g, nil
+}
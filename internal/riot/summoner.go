@@ -0,0 +1,25 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+)
+
+// Summoner represents a LoL summoner from the Summoner-V4 API
+type Summoner struct {
+	PUUID         string `json:"puuid"`
+	ProfileIconID int    `json:"profileIconId"`
+	SummonerLevel int64  `json:"summonerLevel"`
+}
+
+// GetSummonerByPUUID retrieves a player's LoL summoner (Summoner-V4)
+func (c *Client) GetSummonerByPUUID(ctx context.Context, puuid string) (*Summoner, error) {
+	endpoint := fmt.Sprintf("%s/lol/summoner/v4/summoners/by-puuid/%s", c.platformURL, puuid)
+
+	var summoner Summoner
+	if err := c.get(ctx, endpoint, &summoner); err != nil {
+		return nil, fmt.Errorf("failed to get summoner: %w", err)
+	}
+
+	return &summoner, nil
+}
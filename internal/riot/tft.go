@@ -0,0 +1,175 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TFTMatch represents match data from the TFT-Match-V1 API
+type TFTMatch struct {
+	Metadata TFTMatchMetadata `json:"metadata"`
+	Info     TFTMatchInfo     `json:"info"`
+}
+
+// TFTMatchMetadata contains TFT match metadata
+type TFTMatchMetadata struct {
+	MatchID      string   `json:"match_id"`
+	Participants []string `json:"participants"` // PUUIDs
+}
+
+// TFTMatchInfo contains detailed TFT match information
+type TFTMatchInfo struct {
+	GameDatetime int64            `json:"game_datetime"` // Unix timestamp in ms
+	GameLength   float64          `json:"game_length"`   // in seconds
+	QueueID      int              `json:"queue_id"`
+	TFTSetNumber int              `json:"tft_set_number"`
+	TFTGameType  string           `json:"tft_game_type"`
+	Participants []TFTParticipant `json:"participants"`
+}
+
+// TFTParticipant represents a player in a TFT match
+type TFTParticipant struct {
+	PUUID                string     `json:"puuid"`
+	Placement            int        `json:"placement"`
+	Level                int        `json:"level"`
+	LastRound            int        `json:"last_round"`
+	GoldLeft             int        `json:"gold_left"`
+	PlayersEliminated    int        `json:"players_eliminated"`
+	TotalDamageToPlayers int        `json:"total_damage_to_players"`
+	Augments             []string   `json:"augments"`
+	Traits               []TFTTrait `json:"traits"`
+	Units                []TFTUnit  `json:"units"`
+}
+
+// TFTTrait is a trait (synergy) on a player's final board
+type TFTTrait struct {
+	Name        string `json:"name"`
+	NumUnits    int    `json:"num_units"`
+	Style       int    `json:"style"` // 0 = inactive, 1 = bronze, 2 = silver, 3 = gold, 4 = chromatic
+	TierCurrent int    `json:"tier_current"`
+	TierTotal   int    `json:"tier_total"`
+}
+
+// TFTUnit is a champion on a player's final board
+type TFTUnit struct {
+	CharacterID string   `json:"character_id"`
+	Tier        int      `json:"tier"` // Star level
+	Rarity      int      `json:"rarity"`
+	ItemNames   []string `json:"itemNames"`
+}
+
+// LeagueEntry represents a ranked queue entry from the League-V4 / TFT-League-V1 APIs
+type LeagueEntry struct {
+	QueueType    string `json:"queueType"`
+	Tier         string `json:"tier"`
+	Rank         string `json:"rank"`
+	LeaguePoints int    `json:"leaguePoints"`
+	Wins         int    `json:"wins"`
+	Losses       int    `json:"losses"`
+}
+
+// WinRate returns the entry's win percentage
+func (e LeagueEntry) WinRate() float64 {
+	if e.Wins+e.Losses == 0 {
+		return 0
+	}
+	return float64(e.Wins) * 100 / float64(e.Wins+e.Losses)
+}
+
+// GetTFTMatchIDsByPUUID retrieves recent TFT match IDs for a player
+func (c *Client) GetTFTMatchIDsByPUUID(ctx context.Context, puuid string, count int) ([]string, error) {
+	if count <= 0 {
+		count = 5
+	}
+	if count > 100 {
+		count = 100
+	}
+
+	endpoint := fmt.Sprintf("%s/tft/match/v1/matches/by-puuid/%s/ids?count=%d",
+		c.regionalURL, puuid, count)
+
+	var matchIDs []string
+	if err := c.get(ctx, endpoint, &matchIDs); err != nil {
+		return nil, fmt.Errorf("failed to get TFT match IDs: %w", err)
+	}
+
+	return matchIDs, nil
+}
+
+// GetTFTMatch retrieves detailed TFT match information
+func (c *Client) GetTFTMatch(ctx context.Context, matchID string) (*TFTMatch, error) {
+	endpoint := fmt.Sprintf("%s/tft/match/v1/matches/%s", c.regionalURL, matchID)
+
+	var match TFTMatch
+	if err := c.get(ctx, endpoint, &match); err != nil {
+		return nil, fmt.Errorf("failed to get TFT match: %w", err)
+	}
+
+	return &match, nil
+}
+
+// GetTFTLeagueEntries retrieves a player's ranked TFT entries
+func (c *Client) GetTFTLeagueEntries(ctx context.Context, puuid string) ([]LeagueEntry, error) {
+	endpoint := fmt.Sprintf("%s/tft/league/v1/by-puuid/%s", c.platformURL, puuid)
+
+	var entries []LeagueEntry
+	if err := c.get(ctx, endpoint, &entries); err != nil {
+		return nil, fmt.Errorf("failed to get TFT league entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// FindParticipant finds a participant in the TFT match by PUUID
+func (m *TFTMatch) FindParticipant(puuid string) *TFTParticipant {
+	for i := range m.Info.Participants {
+		if m.Info.Participants[i].PUUID == puuid {
+			return &m.Info.Participants[i]
+		}
+	}
+	return nil
+}
+
+// ActiveTraits returns the participant's active traits, strongest first
+func (p *TFTParticipant) ActiveTraits() []TFTTrait {
+	traits := make([]TFTTrait, 0, len(p.Traits))
+	for _, t := range p.Traits {
+		if t.Style > 0 {
+			traits = append(traits, t)
+		}
+	}
+	sort.SliceStable(traits, func(i, j int) bool {
+		if traits[i].Style != traits[j].Style {
+			return traits[i].Style > traits[j].Style
+		}
+		return traits[i].NumUnits > traits[j].NumUnits
+	})
+	return traits
+}
+
+// TFTDisplayName strips the set prefix from a TFT API identifier
+// (e.g. "TFT13_Jinx" → "Jinx", "TFT9_Augment_CyberneticImplants" → "CyberneticImplants")
+func TFTDisplayName(id string) string {
+	name := id
+	if idx := strings.LastIndex(name, "_"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
+
+// GetTFTQueueName returns a human-readable TFT queue name
+func GetTFTQueueName(queueID int) string {
+	queueNames := map[int]string{
+		1090: "Normal",
+		1100: "Ranked",
+		1130: "Hyper Roll",
+		1160: "Double Up",
+	}
+
+	if name, ok := queueNames[queueID]; ok {
+		return name
+	}
+	return "TFT"
+}
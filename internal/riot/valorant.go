@@ -0,0 +1,257 @@
+package riot
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// VALMatchlist represents a player's match history from the VAL-Match-V1 API
+type VALMatchlist struct {
+	PUUID   string              `json:"puuid"`
+	History []VALMatchlistEntry `json:"history"`
+}
+
+// VALMatchlistEntry is a single match in a player's history
+type VALMatchlistEntry struct {
+	MatchID             string `json:"matchId"`
+	GameStartTimeMillis int64  `json:"gameStartTimeMillis"`
+	QueueID             string `json:"queueId"`
+}
+
+// VALMatch represents match data from the VAL-Match-V1 API
+type VALMatch struct {
+	MatchInfo    VALMatchInfo     `json:"matchInfo"`
+	Players      []VALPlayer      `json:"players"`
+	Teams        []VALTeam        `json:"teams"`
+	RoundResults []VALRoundResult `json:"roundResults"`
+}
+
+// VALMatchInfo contains Valorant match metadata
+type VALMatchInfo struct {
+	MatchID          string `json:"matchId"`
+	MapID            string `json:"mapId"` // Asset path, e.g. "/Game/Maps/Ascent/Ascent"
+	GameLengthMillis int64  `json:"gameLengthMillis"`
+	GameStartMillis  int64  `json:"gameStartMillis"`
+	QueueID          string `json:"queueId"`
+	GameMode         string `json:"gameMode"`
+	IsCompleted      bool   `json:"isCompleted"`
+	IsRanked         bool   `json:"isRanked"`
+	SeasonID         string `json:"seasonId"`
+}
+
+// VALPlayer represents a player in a Valorant match
+type VALPlayer struct {
+	PUUID           string          `json:"puuid"`
+	GameName        string          `json:"gameName"`
+	TagLine         string          `json:"tagLine"`
+	TeamID          string          `json:"teamId"`
+	CharacterID     string          `json:"characterId"`
+	CompetitiveTier int             `json:"competitiveTier"`
+	Stats           *VALPlayerStats `json:"stats"`
+}
+
+// VALPlayerStats are a player's totals over a match
+type VALPlayerStats struct {
+	Score        int `json:"score"`
+	RoundsPlayed int `json:"roundsPlayed"`
+	Kills        int `json:"kills"`
+	Deaths       int `json:"deaths"`
+	Assists      int `json:"assists"`
+}
+
+// VALTeam is a team's result in a Valorant match
+type VALTeam struct {
+	TeamID       string `json:"teamId"`
+	Won          bool   `json:"won"`
+	RoundsPlayed int    `json:"roundsPlayed"`
+	RoundsWon    int    `json:"roundsWon"`
+}
+
+// VALRoundResult contains per-round player statistics
+type VALRoundResult struct {
+	RoundNum    int                  `json:"roundNum"`
+	PlayerStats []VALRoundPlayerStat `json:"playerStats"`
+}
+
+// VALRoundPlayerStat is a player's statistics for a single round
+type VALRoundPlayerStat struct {
+	PUUID  string      `json:"puuid"`
+	Damage []VALDamage `json:"damage"`
+	Score  int         `json:"score"`
+}
+
+// VALDamage is damage dealt to one receiver in a round
+type VALDamage struct {
+	Receiver  string `json:"receiver"`
+	Damage    int    `json:"damage"`
+	Legshots  int    `json:"legshots"`
+	Bodyshots int    `json:"bodyshots"`
+	Headshots int    `json:"headshots"`
+}
+
+// VALContent represents game content from the VAL-Content-V1 API
+type VALContent struct {
+	Version    string           `json:"version"`
+	Characters []VALContentItem `json:"characters"`
+	Maps       []VALContentItem `json:"maps"`
+	Acts       []VALContentItem `json:"acts"`
+}
+
+// VALContentItem is a named content entry (agent, map, act, ...)
+type VALContentItem struct {
+	Name           string            `json:"name"`
+	ID             string            `json:"id"`
+	AssetName      string            `json:"assetName"`
+	AssetPath      string            `json:"assetPath"`
+	LocalizedNames map[string]string `json:"localizedNames"`
+}
+
+// DisplayName returns the localized name if available, otherwise the default name
+func (i *VALContentItem) DisplayName(locale string) string {
+	if name, ok := i.LocalizedNames[locale]; ok && name != "" {
+		return name
+	}
+	return i.Name
+}
+
+// GetVALMatchlist retrieves a player's Valorant match history
+func (c *Client) GetVALMatchlist(ctx context.Context, puuid string) (*VALMatchlist, error) {
+	endpoint := fmt.Sprintf("%s/val/match/v1/matchlists/by-puuid/%s", c.platformURL, puuid)
+
+	var matchlist VALMatchlist
+	if err := c.get(ctx, endpoint, &matchlist); err != nil {
+		return nil, fmt.Errorf("failed to get Valorant matchlist: %w", err)
+	}
+
+	return &matchlist, nil
+}
+
+// GetVALMatch retrieves detailed Valorant match information
+func (c *Client) GetVALMatch(ctx context.Context, matchID string) (*VALMatch, error) {
+	endpoint := fmt.Sprintf("%s/val/match/v1/matches/%s", c.platformURL, matchID)
+
+	var match VALMatch
+	if err := c.get(ctx, endpoint, &match); err != nil {
+		return nil, fmt.Errorf("failed to get Valorant match: %w", err)
+	}
+
+	return &match, nil
+}
+
+// GetVALContent retrieves Valorant content (agents, maps, acts) with localized names
+// An empty locale returns the names of every locale
+func (c *Client) GetVALContent(ctx context.Context, locale string) (*VALContent, error) {
+	endpoint := fmt.Sprintf("%s/val/content/v1/contents", c.platformURL)
+	if locale != "" {
+		endpoint += "?locale=" + url.QueryEscape(locale)
+	}
+
+	var content VALContent
+	if err := c.get(ctx, endpoint, &content); err != nil {
+		return nil, fmt.Errorf("failed to get Valorant content: %w", err)
+	}
+
+	return &content, nil
+}
+
+// FindPlayer finds a player in the Valorant match by PUUID
+func (m *VALMatch) FindPlayer(puuid string) *VALPlayer {
+	for i := range m.Players {
+		if m.Players[i].PUUID == puuid {
+			return &m.Players[i]
+		}
+	}
+	return nil
+}
+
+// FindTeam finds a team in the Valorant match by team ID
+func (m *VALMatch) FindTeam(teamID string) *VALTeam {
+	for i := range m.Teams {
+		if m.Teams[i].TeamID == teamID {
+			return &m.Teams[i]
+		}
+	}
+	return nil
+}
+
+// HeadshotRate returns the share of a player's hits that were headshots (0-1)
+func (m *VALMatch) HeadshotRate(puuid string) float64 {
+	var head, total int
+	for _, round := range m.RoundResults {
+		for _, stat := range round.PlayerStats {
+			if stat.PUUID != puuid {
+				continue
+			}
+			for _, d := range stat.Damage {
+				head += d.Headshots
+				total += d.Headshots + d.Bodyshots + d.Legshots
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(head) / float64(total)
+}
+
+// FindCharacter finds an agent by ID (case-insensitive, as match data uses upper case)
+func (c *VALContent) FindCharacter(id string) *VALContentItem {
+	for i := range c.Characters {
+		if strings.EqualFold(c.Characters[i].ID, id) {
+			return &c.Characters[i]
+		}
+	}
+	return nil
+}
+
+// FindMap finds a map by its asset path as used in VALMatchInfo.MapID
+func (c *VALContent) FindMap(assetPath string) *VALContentItem {
+	for i := range c.Maps {
+		if strings.EqualFold(c.Maps[i].AssetPath, assetPath) {
+			return &c.Maps[i]
+		}
+	}
+	return nil
+}
+
+// GetVALTierName returns the name of a Valorant competitive tier
+func GetVALTierName(tier int) string {
+	tiers := []string{
+		"Unranked", "Unused1", "Unused2",
+		"Iron 1", "Iron 2", "Iron 3",
+		"Bronze 1", "Bronze 2", "Bronze 3",
+		"Silver 1", "Silver 2", "Silver 3",
+		"Gold 1", "Gold 2", "Gold 3",
+		"Platinum 1", "Platinum 2", "Platinum 3",
+		"Diamond 1", "Diamond 2", "Diamond 3",
+		"Ascendant 1", "Ascendant 2", "Ascendant 3",
+		"Immortal 1", "Immortal 2", "Immortal 3",
+		"Radiant",
+	}
+
+	if tier < 0 || tier >= len(tiers) || tier == 1 || tier == 2 {
+		return "Unranked"
+	}
+	return tiers[tier]
+}
+
+// GetVALQueueName returns a human-readable Valorant queue name
+func GetVALQueueName(queueID string) string {
+	queueNames := map[string]string{
+		"competitive": "Competitive",
+		"unrated":     "Unrated",
+		"swiftplay":   "Swiftplay",
+		"spikerush":   "Spike Rush",
+		"deathmatch":  "Deathmatch",
+		"ggteam":      "Escalation",
+		"hurm":        "Team Deathmatch",
+		"premier":     "Premier",
+	}
+
+	if name, ok := queueNames[queueID]; ok {
+		return name
+	}
+	return "Custom Game"
+}
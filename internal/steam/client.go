@@ -0,0 +1,96 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	BaseURL = "https://api.steampowered.com"
+)
+
+// Client is a Steam Web API client with rate limiting
+type Client struct {
+	apiKey     string
+	httpClient *http.Client
+	baseURL    string
+
+	// Simple rate limiter
+	mu          sync.Mutex
+	lastRequest time.Time
+	minInterval time.Duration
+}
+
+// NewClient creates a new Steam Web API client
+func NewClient(apiKey string) *Client {
+	return &Client{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		baseURL: BaseURL,
+		// Rate limit: ~10 requests per second (the daily quota is 100,000 calls)
+		minInterval: 100 * time.Millisecond,
+	}
+}
+
+// doRequest performs an HTTP request with rate limiting
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	// Simple rate limiting
+	c.mu.Lock()
+	elapsed := time.Since(c.lastRequest)
+	if elapsed < c.minInterval {
+		time.Sleep(c.minInterval - elapsed)
+	}
+	c.lastRequest = time.Now()
+	c.mu.Unlock()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle rate limiting (429)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+		// Wait and retry once
+		time.Sleep(1 * time.Second)
+		return c.httpClient.Do(req)
+	}
+
+	return resp, nil
+}
+
+// get performs a GET request against a Web API method and decodes the JSON response
+// The API key is added to the query parameters
+func (c *Client) get(method string, params url.Values, result interface{}) error {
+	params.Set("key", c.apiKey)
+	endpoint := fmt.Sprintf("%s/%s/?%s", c.baseURL, method, params.Encode())
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
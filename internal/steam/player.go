@@ -0,0 +1,52 @@
+package steam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OwnedGame represents a game from IPlayerService/GetOwnedGames
+type OwnedGame struct {
+	AppID           int    `json:"appid"`
+	Name            string `json:"name"`
+	PlaytimeForever int    `json:"playtime_forever"` // in minutes
+	Playtime2Weeks  int    `json:"playtime_2weeks"`  // in minutes
+	ImgIconURL      string `json:"img_icon_url"`
+}
+
+// IconURL returns the full URL of the game's icon
+func (g *OwnedGame) IconURL() string {
+	if g.ImgIconURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://media.steampowered.com/steamcommunity/public/images/apps/%d/%s.jpg", g.AppID, g.ImgIconURL)
+}
+
+// GetOwnedGames retrieves a player's library with playtime and app names
+// Returns an empty list for private profiles
+func (c *Client) GetOwnedGames(ctx context.Context, steamID string) ([]OwnedGame, error) {
+	var result struct {
+		Response struct {
+			GameCount int         `json:"game_count"`
+			Games     []OwnedGame `json:"games"`
+		} `json:"response"`
+	}
+
+	params := url.Values{
+		"steamid":                   {steamID},
+		"include_appinfo":           {"1"},
+		"include_played_free_games": {"1"},
+	}
+	if err := c.get("IPlayerService/GetOwnedGames/v1", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get owned games: %w", err)
+	}
+
+	return result.Response.Games, nil
+}
+
+// StoreURL returns the Steam store page of an app
+func StoreURL(appID int) string {
+	return "https://store.steampowered.com/app/" + strconv.Itoa(appID)
+}
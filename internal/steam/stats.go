@@ -0,0 +1,121 @@
+package steam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Language is the language used for achievement names and descriptions
+const Language = "koreana"
+
+// PlayerAchievement is a player's progress on a single achievement
+type PlayerAchievement struct {
+	APIName     string `json:"apiname"`
+	Achieved    int    `json:"achieved"`
+	UnlockTime  int64  `json:"unlocktime"` // Unix timestamp in seconds
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// AchievementSchema describes an achievement from ISteamUserStats/GetSchemaForGame
+type AchievementSchema struct {
+	Name        string `json:"name"` // API name
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Icon        string `json:"icon"`
+	IconGray    string `json:"icongray"`
+	Hidden      int    `json:"hidden"`
+}
+
+// percent decodes a percentage that the API returns as either a number or a string
+type percent float64
+
+func (p *percent) UnmarshalJSON(data []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		n = json.Number(s)
+	}
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return err
+	}
+	*p = percent(f)
+	return nil
+}
+
+// GetPlayerAchievements retrieves a player's achievements for a game
+func (c *Client) GetPlayerAchievements(ctx context.Context, steamID string, appID int) ([]PlayerAchievement, error) {
+	var result struct {
+		PlayerStats struct {
+			GameName     string              `json:"gameName"`
+			Achievements []PlayerAchievement `json:"achievements"`
+			Success      bool                `json:"success"`
+		} `json:"playerstats"`
+	}
+
+	params := url.Values{
+		"steamid": {steamID},
+		"appid":   {strconv.Itoa(appID)},
+		"l":       {Language},
+	}
+	if err := c.get("ISteamUserStats/GetPlayerAchievements/v1", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get player achievements: %w", err)
+	}
+
+	return result.PlayerStats.Achievements, nil
+}
+
+// GetAchievementSchema retrieves the achievement definitions (with icons) of a game, keyed by API name
+func (c *Client) GetAchievementSchema(ctx context.Context, appID int) (map[string]AchievementSchema, error) {
+	var result struct {
+		Game struct {
+			AvailableGameStats struct {
+				Achievements []AchievementSchema `json:"achievements"`
+			} `json:"availableGameStats"`
+		} `json:"game"`
+	}
+
+	params := url.Values{
+		"appid": {strconv.Itoa(appID)},
+		"l":     {Language},
+	}
+	if err := c.get("ISteamUserStats/GetSchemaForGame/v2", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get achievement schema: %w", err)
+	}
+
+	schema := make(map[string]AchievementSchema)
+	for _, a := range result.Game.AvailableGameStats.Achievements {
+		schema[a.Name] = a
+	}
+	return schema, nil
+}
+
+// GetGlobalAchievementPercentages retrieves the share of players who unlocked each achievement, keyed by API name
+func (c *Client) GetGlobalAchievementPercentages(ctx context.Context, appID int) (map[string]float64, error) {
+	var result struct {
+		AchievementPercentages struct {
+			Achievements []struct {
+				Name    string  `json:"name"`
+				Percent percent `json:"percent"`
+			} `json:"achievements"`
+		} `json:"achievementpercentages"`
+	}
+
+	params := url.Values{"gameid": {strconv.Itoa(appID)}}
+	if err := c.get("ISteamUserStats/GetGlobalAchievementPercentagesForApp/v2", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get global achievement percentages: %w", err)
+	}
+
+	percentages := make(map[string]float64)
+	for _, a := range result.AchievementPercentages.Achievements {
+		percentages[a.Name] = float64(a.Percent)
+	}
+	return percentages, nil
+}
@@ -0,0 +1,63 @@
+package steam
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// PlayerSummary represents a profile from ISteamUser/GetPlayerSummaries
+type PlayerSummary struct {
+	SteamID     string `json:"steamid"`
+	PersonaName string `json:"personaname"`
+	ProfileURL  string `json:"profileurl"`
+	Avatar      string `json:"avatar"`
+	AvatarFull  string `json:"avatarfull"`
+	// 1 = private, 3 = public; owned games and achievements need a public profile
+	CommunityVisibilityState int `json:"communityvisibilitystate"`
+	// Set while the player is in a game, unless their game details are private
+	GameID        string `json:"gameid"`
+	GameExtraInfo string `json:"gameextrainfo"`
+}
+
+// ResolveVanityURL resolves a custom profile URL name to a SteamID64
+func (c *Client) ResolveVanityURL(ctx context.Context, vanity string) (string, error) {
+	var result struct {
+		Response struct {
+			SteamID string `json:"steamid"`
+			Success int    `json:"success"`
+			Message string `json:"message"`
+		} `json:"response"`
+	}
+
+	params := url.Values{"vanityurl": {vanity}}
+	if err := c.get("ISteamUser/ResolveVanityURL/v1", params, &result); err != nil {
+		return "", fmt.Errorf("failed to resolve vanity URL: %w", err)
+	}
+
+	if result.Response.Success != 1 || result.Response.SteamID == "" {
+		return "", fmt.Errorf("vanity URL not found: %s", vanity)
+	}
+
+	return result.Response.SteamID, nil
+}
+
+// GetPlayerSummary retrieves a player's public profile
+func (c *Client) GetPlayerSummary(ctx context.Context, steamID string) (*PlayerSummary, error) {
+	var result struct {
+		Response struct {
+			Players []PlayerSummary `json:"players"`
+		} `json:"response"`
+	}
+
+	params := url.Values{"steamids": {steamID}}
+	if err := c.get("ISteamUser/GetPlayerSummaries/v2", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get player summary: %w", err)
+	}
+
+	if len(result.Response.Players) == 0 {
+		return nil, fmt.Errorf("player not found: %s", steamID)
+	}
+
+	return &result.Response.Players[0], nil
+}
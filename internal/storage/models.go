@@ -14,18 +14,242 @@ type Summoner struct {
 	UpdatedAt   time.Time
 }
 
+// PlayerState is the latest versioned tracker state of a summoner
+// Data is tracker-specific JSON; version 0 holds a legacy last_match_id string
+type PlayerState struct {
+	SummonerID int64
+	Version    int
+	Data       string
+	UpdatedAt  time.Time
+}
+
 // GuildSettings stores per-server configuration
 type GuildSettings struct {
 	GuildID               string
 	NotificationChannelID string
+	Language              string // i18n locale; empty follows each member's Discord locale
+	QuietHours            QuietHours
+	QuietMode             QuietMode
+	BurstLimit            int // notifications per minute before the rest are collapsed; 0 disables
 	CreatedAt             time.Time
 }
 
-// Subscription links a summoner to a Discord guild
+// DefaultBurstLimit is the burst limit of guilds that never changed it
+const DefaultBurstLimit = 5
+
+// DefaultGuildSettings returns the settings of a guild that never changed them
+func DefaultGuildSettings(guildID string) *GuildSettings {
+	return &GuildSettings{
+		GuildID:    guildID,
+		QuietHours: QuietHours{Timezone: "Asia/Seoul"},
+		QuietMode:  QuietDigest,
+		BurstLimit: DefaultBurstLimit,
+	}
+}
+
+// QuietMode is what happens to a guild's notifications during quiet hours
+type QuietMode string
+
+const (
+	QuietDigest QuietMode = "digest" // queued and posted as one digest when the window ends
+	QuietDrop   QuietMode = "drop"   // discarded
+)
+
+// QueuedNotification is a notification held back during a guild's quiet hours
+type QueuedNotification struct {
+	ID         int64
+	GuildID    string
+	GameType   string
+	GameName   string
+	PlayerName string
+	Title      string
+	URL        string
+
+	// MentionUsers and MentionRoles are pinged when the digest is sent
+	MentionUsers []string
+	MentionRoles []string
+	CreatedAt    time.Time
+}
+
+// NotificationRoute sends a guild's notifications to a destination
+// An empty GameType matches every game; a guild with no matching route
+// falls back to its notification channel
+type NotificationRoute struct {
+	ID        int64
+	GuildID   string
+	GameType  string
+	Sink      string // notify.Kind
+	Target    string // channel ID or webhook URL
+	Username  string // Discord webhook username override
+	AvatarURL string // Discord webhook avatar override
+	CreatedAt time.Time
+}
+
+// TargetType is who receives a subscription's notifications
+type TargetType string
+
+const (
+	TargetGuild TargetType = "guild" // a guild's notification channel and routes
+	TargetUser  TargetType = "user"  // a user's direct messages
+)
+
+// Subscription links a summoner to a Discord guild, or to a user for
+// personal DM notifications
 type Subscription struct {
 	ID           int64
 	SummonerID   int64
-	GuildID      string
+	TargetType   TargetType
+	TargetID     string // guild ID, or user ID for TargetUser
 	RegisteredBy string // Discord user ID
+	Muted        bool   // notifications silenced for this target
 	CreatedAt    time.Time
 }
+
+// PlayerLink marks a Discord user as the owner of a tracked player
+// A player has at most one owner, who can be mentioned in its notifications
+type PlayerLink struct {
+	SummonerID int64
+	UserID     string
+	Verified   bool // the user proved they own the account in game
+	CreatedAt  time.Time
+}
+
+// MentionType is who a mention rule pings
+type MentionType string
+
+const (
+	MentionOwner MentionType = "owner" // the user linked to the player
+	MentionRole  MentionType = "role"  // a guild role
+	MentionUser  MentionType = "user"  // a specific user
+)
+
+// MentionPromotion is the mention rule event for rank changes to a higher tier or division
+const MentionPromotion = "promoted"
+
+// MentionRule pings a role or user when a guild notification contains an event
+// An empty GameType matches every game
+type MentionRule struct {
+	ID          int64
+	GuildID     string
+	GameType    string
+	Event       string // game event type, or MentionPromotion
+	MentionType MentionType
+	MentionID   string // role or user ID; empty for MentionOwner
+	CreatedAt   time.Time
+}
+
+// QuietHours is a daily window in which notifications are held back
+// Start and End are hours of the day in Timezone; equal hours disable it
+type QuietHours struct {
+	Start    int
+	End      int
+	Timezone string // IANA name, e.g. Asia/Seoul
+}
+
+// Enabled reports whether the window is set
+func (q QuietHours) Enabled() bool {
+	return q.Start != q.End
+}
+
+// Active reports whether t falls in the window
+// Windows may wrap past midnight, e.g. 23 to 8
+func (q QuietHours) Active(t time.Time) bool {
+	if !q.Enabled() {
+		return false
+	}
+	if loc, err := time.LoadLocation(q.Timezone); err == nil {
+		t = t.In(loc)
+	}
+	h := t.Hour()
+	if q.Start < q.End {
+		return h >= q.Start && h < q.End
+	}
+	return h >= q.Start || h < q.End
+}
+
+// UserSettings stores per-user configuration for personal notifications
+type UserSettings struct {
+	UserID     string
+	QuietHours QuietHours
+	Language   string // locale code of DMs, "" for the default
+	CreatedAt  time.Time
+}
+
+// DefaultUserSettings returns the settings of a user who never changed them
+func DefaultUserSettings(userID string) *UserSettings {
+	return &UserSettings{
+		UserID:     userID,
+		QuietHours: QuietHours{Timezone: "Asia/Seoul"},
+	}
+}
+
+// MatchResult is a completed match recorded for recap reports
+type MatchResult struct {
+	ID         int64
+	SummonerID int64
+	MatchID    string
+	Win        bool
+	Kills      int
+	Deaths     int
+	Assists    int
+	Character  string // champion, agent, etc.
+	PlayedAt   time.Time
+}
+
+// KDA returns (kills + assists) / deaths, treating zero deaths as one
+func (m *MatchResult) KDA() float64 {
+	return float64(m.Kills+m.Assists) / float64(max(m.Deaths, 1))
+}
+
+// ReportSchedule configures a guild's weekly and monthly recap reports
+// Weekly recaps are sent on Weekday, monthly recaps on the 1st, both at Hour:Minute in Timezone
+type ReportSchedule struct {
+	GuildID     string
+	Weekday     time.Weekday
+	Hour        int
+	Minute      int
+	Timezone    string // IANA name, e.g. Asia/Seoul
+	Weekly      bool
+	Monthly     bool
+	LastWeekly  string // ISO week of the last weekly recap, e.g. 2026-W42
+	LastMonthly string // month of the last monthly recap, e.g. 2026-10
+}
+
+// DefaultReportSchedule returns the schedule used until a guild configures one:
+// weekly recaps on Mondays and monthly recaps on the 1st, at 09:00 KST
+func DefaultReportSchedule(guildID string) *ReportSchedule {
+	return &ReportSchedule{
+		GuildID:  guildID,
+		Weekday:  time.Monday,
+		Hour:     9,
+		Timezone: "Asia/Seoul",
+		Weekly:   true,
+		Monthly:  true,
+	}
+}
+
+// PinnedLeaderboard is a leaderboard message kept up to date after each poll
+type PinnedLeaderboard struct {
+	GuildID   string
+	Metric    string
+	Games     int // match window for per-game metrics
+	ChannelID string
+	MessageID string
+	CreatedAt time.Time
+}
+
+// CharacterSnapshot is a daily snapshot of a progression-based character
+type CharacterSnapshot struct {
+	ID         int64
+	SummonerID int64
+	Date       string // YYYY-MM-DD in the game's timezone
+	Level      int64
+	Exp        int64
+	ExpRate    float64 // EXP percentage within the level
+	CreatedAt  time.Time
+}
+
+// Progress returns the level plus the fraction of the level completed
+func (s *CharacterSnapshot) Progress() float64 {
+	return float64(s.Level) + s.ExpRate/100
+}
@@ -2,9 +2,12 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "modernc.org/sqlite"
@@ -65,20 +68,130 @@ func (r *Repository) migrate() error {
 		`CREATE TABLE IF NOT EXISTS guild_settings (
 			guild_id VARCHAR(20) PRIMARY KEY,
 			notification_channel_id VARCHAR(20),
+			language VARCHAR(10) NOT NULL DEFAULT '',
+			quiet_start INTEGER NOT NULL DEFAULT 0,
+			quiet_end INTEGER NOT NULL DEFAULT 0,
+			quiet_timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul',
+			quiet_mode VARCHAR(10) NOT NULL DEFAULT 'digest',
+			burst_limit INTEGER NOT NULL DEFAULT 5,
 			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 		)`,
 		`CREATE TABLE IF NOT EXISTS summoner_subscriptions (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			summoner_id INTEGER NOT NULL,
 			guild_id VARCHAR(20) NOT NULL,
+			target_type VARCHAR(10) NOT NULL DEFAULT 'guild',
 			registered_by VARCHAR(20) NOT NULL,
+			muted BOOLEAN NOT NULL DEFAULT 0,
 			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (summoner_id) REFERENCES summoners(id) ON DELETE CASCADE,
 			UNIQUE(summoner_id, guild_id)
 		)`,
+		`CREATE TABLE IF NOT EXISTS user_settings (
+			user_id VARCHAR(20) PRIMARY KEY,
+			quiet_start INTEGER NOT NULL DEFAULT 0,
+			quiet_end INTEGER NOT NULL DEFAULT 0,
+			timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul',
+			language VARCHAR(10) NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS player_links (
+			summoner_id INTEGER PRIMARY KEY,
+			user_id VARCHAR(20) NOT NULL,
+			verified BOOLEAN NOT NULL DEFAULT 0,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (summoner_id) REFERENCES summoners(id) ON DELETE CASCADE
+		)`,
+		`CREATE TABLE IF NOT EXISTS mention_rules (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			guild_id VARCHAR(20) NOT NULL,
+			game_type VARCHAR(32) NOT NULL DEFAULT '',
+			event VARCHAR(32) NOT NULL,
+			mention_type VARCHAR(10) NOT NULL,
+			mention_id VARCHAR(20) NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS player_states (
+			summoner_id INTEGER PRIMARY KEY,
+			version INTEGER NOT NULL,
+			data TEXT NOT NULL,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (summoner_id) REFERENCES summoners(id) ON DELETE CASCADE
+		)`,
+		`CREATE TABLE IF NOT EXISTS character_snapshots (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			summoner_id INTEGER NOT NULL,
+			snapshot_date VARCHAR(10) NOT NULL,
+			level INTEGER NOT NULL,
+			exp INTEGER NOT NULL,
+			exp_rate REAL NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (summoner_id) REFERENCES summoners(id) ON DELETE CASCADE,
+			UNIQUE(summoner_id, snapshot_date)
+		)`,
+		`CREATE TABLE IF NOT EXISTS notification_routes (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			guild_id VARCHAR(20) NOT NULL,
+			game_type VARCHAR(32) NOT NULL DEFAULT '',
+			sink VARCHAR(20) NOT NULL,
+			target TEXT NOT NULL,
+			username VARCHAR(80) NOT NULL DEFAULT '',
+			avatar_url TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS queued_notifications (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			guild_id VARCHAR(20) NOT NULL,
+			game_type VARCHAR(32) NOT NULL,
+			game_name VARCHAR(50) NOT NULL,
+			player_name VARCHAR(100) NOT NULL,
+			title TEXT NOT NULL,
+			url TEXT NOT NULL DEFAULT '',
+			mention_users TEXT NOT NULL DEFAULT '',
+			mention_roles TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS match_results (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			summoner_id INTEGER NOT NULL,
+			match_id VARCHAR(100) NOT NULL,
+			win BOOLEAN NOT NULL,
+			kills INTEGER NOT NULL,
+			deaths INTEGER NOT NULL,
+			assists INTEGER NOT NULL,
+			character VARCHAR(50) NOT NULL,
+			played_at TIMESTAMP NOT NULL,
+			FOREIGN KEY (summoner_id) REFERENCES summoners(id) ON DELETE CASCADE,
+			UNIQUE(summoner_id, match_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS report_schedules (
+			guild_id VARCHAR(20) PRIMARY KEY,
+			weekday INTEGER NOT NULL,
+			hour INTEGER NOT NULL,
+			minute INTEGER NOT NULL,
+			timezone VARCHAR(64) NOT NULL,
+			weekly BOOLEAN NOT NULL,
+			monthly BOOLEAN NOT NULL,
+			last_weekly VARCHAR(10) NOT NULL DEFAULT '',
+			last_monthly VARCHAR(10) NOT NULL DEFAULT ''
+		)`,
+		`CREATE TABLE IF NOT EXISTS pinned_leaderboards (
+			guild_id VARCHAR(20) NOT NULL,
+			metric VARCHAR(20) NOT NULL,
+			games INTEGER NOT NULL,
+			channel_id VARCHAR(20) NOT NULL,
+			message_id VARCHAR(20) NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (guild_id, metric)
+		)`,
 		`CREATE INDEX IF NOT EXISTS idx_summoners_puuid ON summoners(puuid)`,
 		`CREATE INDEX IF NOT EXISTS idx_summoners_game_type ON summoners(game_type)`,
 		`CREATE INDEX IF NOT EXISTS idx_subscriptions_guild ON summoner_subscriptions(guild_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_notification_routes_guild ON notification_routes(guild_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_player_links_user ON player_links(user_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_mention_rules_guild ON mention_rules(guild_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_queued_notifications_guild ON queued_notifications(guild_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_match_results_played ON match_results(summoner_id, played_at)`,
 	}
 
 	for _, migration := range migrations {
@@ -90,6 +203,33 @@ func (r *Repository) migrate() error {
 	// Add game_type column if it doesn't exist (for existing databases)
 	r.db.Exec(`ALTER TABLE summoners ADD COLUMN game_type VARCHAR(20) NOT NULL DEFAULT 'lol'`)
 
+	// Add muted column if it doesn't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE summoner_subscriptions ADD COLUMN muted BOOLEAN NOT NULL DEFAULT 0`)
+
+	// Add target_type column if it doesn't exist (for existing databases)
+	// guild_id holds the user ID of personal subscriptions; snowflakes don't collide
+	r.db.Exec(`ALTER TABLE summoner_subscriptions ADD COLUMN target_type VARCHAR(10) NOT NULL DEFAULT 'guild'`)
+
+	// Add language column if it doesn't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT ''`)
+
+	// Add quiet hours and burst columns if they don't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN quiet_start INTEGER NOT NULL DEFAULT 0`)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN quiet_end INTEGER NOT NULL DEFAULT 0`)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN quiet_timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul'`)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN quiet_mode VARCHAR(10) NOT NULL DEFAULT 'digest'`)
+	r.db.Exec(`ALTER TABLE guild_settings ADD COLUMN burst_limit INTEGER NOT NULL DEFAULT 5`)
+
+	// Add verified column if it doesn't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE player_links ADD COLUMN verified BOOLEAN NOT NULL DEFAULT 0`)
+
+	// Add user language column if it doesn't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE user_settings ADD COLUMN language VARCHAR(10) NOT NULL DEFAULT ''`)
+
+	// Add queued mention columns if they don't exist (for existing databases)
+	r.db.Exec(`ALTER TABLE queued_notifications ADD COLUMN mention_users TEXT NOT NULL DEFAULT ''`)
+	r.db.Exec(`ALTER TABLE queued_notifications ADD COLUMN mention_roles TEXT NOT NULL DEFAULT ''`)
+
 	return nil
 }
 
@@ -118,6 +258,19 @@ func (r *Repository) CreateSummoner(s *Summoner) error {
 	return nil
 }
 
+// GetSummonerByID finds a summoner by ID
+func (r *Repository) GetSummonerByID(id int64) (*Summoner, error) {
+	s := &Summoner{}
+	err := r.db.QueryRow(
+		`SELECT id, puuid, riot_id, game_type, region, last_match_id, created_at, updated_at FROM summoners WHERE id = ?`,
+		id,
+	).Scan(&s.ID, &s.PUUID, &s.RiotID, &s.GameType, &s.Region, &s.LastMatchID, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // GetSummonerByPUUID finds a summoner by PUUID
 func (r *Repository) GetSummonerByPUUID(puuid string) (*Summoner, error) {
 	s := &Summoner{}
@@ -224,7 +377,8 @@ func (r *Repository) GetAllSummonersByGame(gameType string) ([]*Summoner, error)
 	return summoners, rows.Err()
 }
 
-// GetSummonersByGuild returns all summoners registered in a guild
+// GetSummonersByGuild returns all summoners registered in a guild, or
+// followed personally by a user when given a user ID
 func (r *Repository) GetSummonersByGuild(guildID string) ([]*Summoner, error) {
 	rows, err := r.db.Query(
 		`SELECT s.id, s.puuid, s.riot_id, s.game_type, s.region, s.last_match_id, s.created_at, s.updated_at
@@ -250,13 +404,61 @@ func (r *Repository) GetSummonersByGuild(guildID string) ([]*Summoner, error) {
 	return summoners, rows.Err()
 }
 
+// Player state operations
+
+// GetPlayerState returns the latest tracker state of a summoner
+// Summoners tracked before player_states existed fall back to their
+// last_match_id, returned as a version 0 (legacy) state
+// Returns nil without error if no state has been recorded yet
+func (r *Repository) GetPlayerState(s *Summoner) (*PlayerState, error) {
+	state := &PlayerState{}
+	err := r.db.QueryRow(
+		`SELECT summoner_id, version, data, updated_at FROM player_states WHERE summoner_id = ?`,
+		s.ID,
+	).Scan(&state.SummonerID, &state.Version, &state.Data, &state.UpdatedAt)
+	if err == nil {
+		return state, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if s.LastMatchID == "" {
+		return nil, nil
+	}
+
+	legacy, err := json.Marshal(s.LastMatchID)
+	if err != nil {
+		return nil, err
+	}
+	return &PlayerState{
+		SummonerID: s.ID,
+		Version:    0,
+		Data:       string(legacy),
+		UpdatedAt:  s.UpdatedAt,
+	}, nil
+}
+
+// UpsertPlayerState creates or replaces the tracker state of a summoner
+func (r *Repository) UpsertPlayerState(state *PlayerState) error {
+	_, err := r.db.Exec(
+		`INSERT INTO player_states (summoner_id, version, data, updated_at) VALUES (?, ?, ?, ?)
+		 ON CONFLICT(summoner_id) DO UPDATE SET version = excluded.version, data = excluded.data, updated_at = excluded.updated_at`,
+		state.SummonerID, state.Version, state.Data, time.Now(),
+	)
+	return err
+}
+
 // Subscription operations
 
 // CreateSubscription creates a new subscription
 func (r *Repository) CreateSubscription(sub *Subscription) error {
+	if sub.TargetType == "" {
+		sub.TargetType = TargetGuild
+	}
 	result, err := r.db.Exec(
-		`INSERT INTO summoner_subscriptions (summoner_id, guild_id, registered_by) VALUES (?, ?, ?)`,
-		sub.SummonerID, sub.GuildID, sub.RegisteredBy,
+		`INSERT INTO summoner_subscriptions (summoner_id, guild_id, target_type, registered_by) VALUES (?, ?, ?, ?)`,
+		sub.SummonerID, sub.TargetID, sub.TargetType, sub.RegisteredBy,
 	)
 	if err != nil {
 		return err
@@ -270,21 +472,63 @@ func (r *Repository) CreateSubscription(sub *Subscription) error {
 	return nil
 }
 
-// DeleteSubscription removes a subscription
-func (r *Repository) DeleteSubscription(summonerID int64, guildID string) error {
+// DeleteSubscription removes the subscription of a summoner for a guild or user
+func (r *Repository) DeleteSubscription(summonerID int64, targetID string) error {
 	_, err := r.db.Exec(
 		`DELETE FROM summoner_subscriptions WHERE summoner_id = ? AND guild_id = ?`,
-		summonerID, guildID,
+		summonerID, targetID,
 	)
 	return err
 }
 
-// GetSubscriptionsByGuild returns all subscriptions for a guild
-func (r *Repository) GetSubscriptionsByGuild(guildID string) ([]*Subscription, error) {
-	rows, err := r.db.Query(
-		`SELECT id, summoner_id, guild_id, registered_by, created_at FROM summoner_subscriptions WHERE guild_id = ?`,
-		guildID,
+// subscriptionColumns are the columns scanned by scanSubscription
+const subscriptionColumns = `id, summoner_id, guild_id, target_type, registered_by, muted, created_at`
+
+// scanSubscription scans a row of subscriptionColumns
+func scanSubscription(row interface{ Scan(...any) error }) (*Subscription, error) {
+	sub := &Subscription{}
+	if err := row.Scan(&sub.ID, &sub.SummonerID, &sub.TargetID, &sub.TargetType, &sub.RegisteredBy, &sub.Muted, &sub.CreatedAt); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// GetSubscription returns the subscription of a summoner for a guild or user
+func (r *Repository) GetSubscription(summonerID int64, targetID string) (*Subscription, error) {
+	return scanSubscription(r.db.QueryRow(
+		`SELECT `+subscriptionColumns+` FROM summoner_subscriptions WHERE summoner_id = ? AND guild_id = ?`,
+		summonerID, targetID,
+	))
+}
+
+// SetSubscriptionMuted mutes or unmutes notifications of a summoner for a guild or user
+// Returns false if the summoner is not registered there
+func (r *Repository) SetSubscriptionMuted(summonerID int64, targetID string, muted bool) (bool, error) {
+	result, err := r.db.Exec(
+		`UPDATE summoner_subscriptions SET muted = ? WHERE summoner_id = ? AND guild_id = ?`,
+		muted, summonerID, targetID,
 	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetSubscriptionsByGuild returns all subscriptions of a guild, or the
+// personal subscriptions of a user when given a user ID
+func (r *Repository) GetSubscriptionsByGuild(targetID string) ([]*Subscription, error) {
+	return r.querySubscriptions(`SELECT `+subscriptionColumns+` FROM summoner_subscriptions WHERE guild_id = ?`, targetID)
+}
+
+// GetSubscriptionsBySummoner returns all guild and personal subscriptions for a summoner
+func (r *Repository) GetSubscriptionsBySummoner(summonerID int64) ([]*Subscription, error) {
+	return r.querySubscriptions(`SELECT `+subscriptionColumns+` FROM summoner_subscriptions WHERE summoner_id = ?`, summonerID)
+}
+
+// querySubscriptions runs a query selecting subscriptionColumns
+func (r *Repository) querySubscriptions(query string, args ...any) ([]*Subscription, error) {
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -292,8 +536,8 @@ func (r *Repository) GetSubscriptionsByGuild(guildID string) ([]*Subscription, e
 
 	var subs []*Subscription
 	for rows.Next() {
-		sub := &Subscription{}
-		if err := rows.Scan(&sub.ID, &sub.SummonerID, &sub.GuildID, &sub.RegisteredBy, &sub.CreatedAt); err != nil {
+		sub, err := scanSubscription(rows)
+		if err != nil {
 			return nil, err
 		}
 		subs = append(subs, sub)
@@ -302,27 +546,196 @@ func (r *Repository) GetSubscriptionsByGuild(guildID string) ([]*Subscription, e
 	return subs, rows.Err()
 }
 
-// GetSubscriptionsBySummoner returns all guild subscriptions for a summoner
-func (r *Repository) GetSubscriptionsBySummoner(summonerID int64) ([]*Subscription, error) {
-	rows, err := r.db.Query(
-		`SELECT id, summoner_id, guild_id, registered_by, created_at FROM summoner_subscriptions WHERE summoner_id = ?`,
+// User settings operations
+
+// GetUserSettings returns the settings of a user
+// Users that never changed them get DefaultUserSettings
+func (r *Repository) GetUserSettings(userID string) (*UserSettings, error) {
+	settings := &UserSettings{UserID: userID}
+	err := r.db.QueryRow(
+		`SELECT quiet_start, quiet_end, timezone, language, created_at FROM user_settings WHERE user_id = ?`,
+		userID,
+	).Scan(&settings.QuietHours.Start, &settings.QuietHours.End, &settings.QuietHours.Timezone, &settings.Language, &settings.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultUserSettings(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpsertUserSettings creates or updates the quiet hours of a user
+// The language is kept; it is set with SetUserLanguage
+func (r *Repository) UpsertUserSettings(settings *UserSettings) error {
+	q := settings.QuietHours
+	_, err := r.db.Exec(
+		`INSERT INTO user_settings (user_id, quiet_start, quiet_end, timezone) VALUES (?, ?, ?, ?)
+		 ON CONFLICT(user_id) DO UPDATE SET quiet_start = excluded.quiet_start, quiet_end = excluded.quiet_end, timezone = excluded.timezone`,
+		settings.UserID, q.Start, q.End, q.Timezone,
+	)
+	return err
+}
+
+// SetUserLanguage sets the language of a user's personal notifications
+func (r *Repository) SetUserLanguage(userID, language string) error {
+	_, err := r.db.Exec(
+		`INSERT INTO user_settings (user_id, language) VALUES (?, ?)
+		 ON CONFLICT(user_id) DO UPDATE SET language = excluded.language`,
+		userID, language,
+	)
+	return err
+}
+
+// Player link operations
+
+// LinkPlayer makes a user the owner of a summoner, replacing any previous owner
+func (r *Repository) LinkPlayer(link *PlayerLink) error {
+	_, err := r.db.Exec(
+		`INSERT INTO player_links (summoner_id, user_id, verified) VALUES (?, ?, ?)
+		 ON CONFLICT(summoner_id) DO UPDATE SET user_id = excluded.user_id, verified = excluded.verified,
+		 created_at = CURRENT_TIMESTAMP`,
+		link.SummonerID, link.UserID, link.Verified,
+	)
+	return err
+}
+
+// UnlinkPlayer removes the owner of a summoner if it is the given user
+// Returns false if the user doesn't own the summoner
+func (r *Repository) UnlinkPlayer(summonerID int64, userID string) (bool, error) {
+	result, err := r.db.Exec(
+		`DELETE FROM player_links WHERE summoner_id = ? AND user_id = ?`,
+		summonerID, userID,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetPlayerLink returns the owner of a summoner
+// Returns sql.ErrNoRows if nobody linked it
+func (r *Repository) GetPlayerLink(summonerID int64) (*PlayerLink, error) {
+	link := &PlayerLink{}
+	err := r.db.QueryRow(
+		`SELECT summoner_id, user_id, verified, created_at FROM player_links WHERE summoner_id = ?`,
 		summonerID,
+	).Scan(&link.SummonerID, &link.UserID, &link.Verified, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// GetPlayerLinksByGuild returns the links of the summoners subscribed by a
+// guild, or followed by a user when given a user ID
+func (r *Repository) GetPlayerLinksByGuild(targetID string) ([]*PlayerLink, error) {
+	rows, err := r.db.Query(
+		`SELECT l.summoner_id, l.user_id, l.verified, l.created_at
+		 FROM player_links l
+		 JOIN summoner_subscriptions ss ON ss.summoner_id = l.summoner_id
+		 WHERE ss.guild_id = ?`,
+		targetID,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var subs []*Subscription
+	var links []*PlayerLink
 	for rows.Next() {
-		sub := &Subscription{}
-		if err := rows.Scan(&sub.ID, &sub.SummonerID, &sub.GuildID, &sub.RegisteredBy, &sub.CreatedAt); err != nil {
+		link := &PlayerLink{}
+		if err := rows.Scan(&link.SummonerID, &link.UserID, &link.Verified, &link.CreatedAt); err != nil {
 			return nil, err
 		}
-		subs = append(subs, sub)
+		links = append(links, link)
 	}
 
-	return subs, rows.Err()
+	return links, rows.Err()
+}
+
+// GetPlayerLinksByUser returns the players linked to a user, oldest first
+func (r *Repository) GetPlayerLinksByUser(userID string) ([]*PlayerLink, error) {
+	rows, err := r.db.Query(
+		`SELECT summoner_id, user_id, verified, created_at
+		 FROM player_links WHERE user_id = ? ORDER BY created_at, summoner_id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*PlayerLink
+	for rows.Next() {
+		link := &PlayerLink{}
+		if err := rows.Scan(&link.SummonerID, &link.UserID, &link.Verified, &link.CreatedAt); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+
+	return links, rows.Err()
+}
+
+// Mention rule operations
+
+// CreateMentionRule adds a mention rule for a guild
+func (r *Repository) CreateMentionRule(rule *MentionRule) error {
+	result, err := r.db.Exec(
+		`INSERT INTO mention_rules (guild_id, game_type, event, mention_type, mention_id) VALUES (?, ?, ?, ?, ?)`,
+		rule.GuildID, rule.GameType, rule.Event, rule.MentionType, rule.MentionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	rule.ID = id
+	return nil
+}
+
+// GetMentionRules returns all mention rules of a guild
+func (r *Repository) GetMentionRules(guildID string) ([]*MentionRule, error) {
+	rows, err := r.db.Query(
+		`SELECT id, guild_id, game_type, event, mention_type, mention_id, created_at
+		 FROM mention_rules WHERE guild_id = ? ORDER BY id`,
+		guildID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*MentionRule
+	for rows.Next() {
+		rule := &MentionRule{}
+		if err := rows.Scan(&rule.ID, &rule.GuildID, &rule.GameType, &rule.Event,
+			&rule.MentionType, &rule.MentionID, &rule.CreatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// DeleteMentionRule removes a mention rule of a guild
+// Returns false if the guild has no rule with that ID
+func (r *Repository) DeleteMentionRule(guildID string, id int64) (bool, error) {
+	result, err := r.db.Exec(
+		`DELETE FROM mention_rules WHERE guild_id = ? AND id = ?`,
+		guildID, id,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
 }
 
 // Guild settings operations
@@ -337,15 +750,405 @@ func (r *Repository) UpsertGuildSettings(settings *GuildSettings) error {
 	return err
 }
 
+// SetGuildLanguage sets the bot language of a guild ("" follows each member's Discord locale)
+func (r *Repository) SetGuildLanguage(guildID, language string) error {
+	_, err := r.db.Exec(
+		`INSERT INTO guild_settings (guild_id, notification_channel_id, language) VALUES (?, '', ?)
+		 ON CONFLICT(guild_id) DO UPDATE SET language = excluded.language`,
+		guildID, language,
+	)
+	return err
+}
+
+// guildSettingsColumns are the columns scanned by scanGuildSettings
+const guildSettingsColumns = `guild_id, COALESCE(notification_channel_id, ''), language,
+	quiet_start, quiet_end, quiet_timezone, quiet_mode, burst_limit, created_at`
+
+// scanGuildSettings scans a row of guildSettingsColumns
+func scanGuildSettings(row interface{ Scan(...any) error }) (*GuildSettings, error) {
+	settings := &GuildSettings{}
+	q := &settings.QuietHours
+	if err := row.Scan(&settings.GuildID, &settings.NotificationChannelID, &settings.Language,
+		&q.Start, &q.End, &q.Timezone, &settings.QuietMode, &settings.BurstLimit, &settings.CreatedAt); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
 // GetGuildSettings retrieves guild settings
 func (r *Repository) GetGuildSettings(guildID string) (*GuildSettings, error) {
-	settings := &GuildSettings{}
+	return scanGuildSettings(r.db.QueryRow(`SELECT `+guildSettingsColumns+` FROM guild_settings WHERE guild_id = ?`, guildID))
+}
+
+// GetAllGuildSettings returns the settings of every guild
+func (r *Repository) GetAllGuildSettings() ([]*GuildSettings, error) {
+	rows, err := r.db.Query(`SELECT ` + guildSettingsColumns + ` FROM guild_settings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var all []*GuildSettings
+	for rows.Next() {
+		settings, err := scanGuildSettings(rows)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, settings)
+	}
+
+	return all, rows.Err()
+}
+
+// SetGuildQuietHours sets the quiet hours of a guild and what happens to
+// notifications during them
+func (r *Repository) SetGuildQuietHours(guildID string, quiet QuietHours, mode QuietMode) error {
+	_, err := r.db.Exec(
+		`INSERT INTO guild_settings (guild_id, notification_channel_id, quiet_start, quiet_end, quiet_timezone, quiet_mode)
+		 VALUES (?, '', ?, ?, ?, ?)
+		 ON CONFLICT(guild_id) DO UPDATE SET quiet_start = excluded.quiet_start, quiet_end = excluded.quiet_end,
+		 quiet_timezone = excluded.quiet_timezone, quiet_mode = excluded.quiet_mode`,
+		guildID, quiet.Start, quiet.End, quiet.Timezone, mode,
+	)
+	return err
+}
+
+// SetGuildBurstLimit sets how many notifications a guild gets per minute
+// before the rest are collapsed into a summary (0 disables collapsing)
+func (r *Repository) SetGuildBurstLimit(guildID string, limit int) error {
+	_, err := r.db.Exec(
+		`INSERT INTO guild_settings (guild_id, notification_channel_id, burst_limit) VALUES (?, '', ?)
+		 ON CONFLICT(guild_id) DO UPDATE SET burst_limit = excluded.burst_limit`,
+		guildID, limit,
+	)
+	return err
+}
+
+// Queued notification operations
+
+// QueueNotification stores a notification held back during quiet hours
+func (r *Repository) QueueNotification(n *QueuedNotification) error {
+	result, err := r.db.Exec(
+		`INSERT INTO queued_notifications (guild_id, game_type, game_name, player_name, title, url, mention_users, mention_roles) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		n.GuildID, n.GameType, n.GameName, n.PlayerName, n.Title, n.URL, strings.Join(n.MentionUsers, " "), strings.Join(n.MentionRoles, " "),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	n.ID = id
+	return nil
+}
+
+// GetQueuedGuilds returns the guilds with queued notifications
+func (r *Repository) GetQueuedGuilds() ([]string, error) {
+	rows, err := r.db.Query(`SELECT DISTINCT guild_id FROM queued_notifications`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var guildIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		guildIDs = append(guildIDs, id)
+	}
+
+	return guildIDs, rows.Err()
+}
+
+// GetQueuedNotifications returns a guild's queued notifications, oldest first
+func (r *Repository) GetQueuedNotifications(guildID string) ([]*QueuedNotification, error) {
+	rows, err := r.db.Query(
+		`SELECT id, guild_id, game_type, game_name, player_name, title, url, mention_users, mention_roles, created_at
+		 FROM queued_notifications WHERE guild_id = ? ORDER BY id`,
+		guildID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queued []*QueuedNotification
+	for rows.Next() {
+		n := &QueuedNotification{}
+		var users, roles string
+		if err := rows.Scan(&n.ID, &n.GuildID, &n.GameType, &n.GameName, &n.PlayerName, &n.Title, &n.URL, &users, &roles, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		n.MentionUsers, n.MentionRoles = strings.Fields(users), strings.Fields(roles)
+		queued = append(queued, n)
+	}
+
+	return queued, rows.Err()
+}
+
+// DeleteQueuedNotifications removes a guild's queued notifications up to and including an ID
+func (r *Repository) DeleteQueuedNotifications(guildID string, upToID int64) error {
+	_, err := r.db.Exec(`DELETE FROM queued_notifications WHERE guild_id = ? AND id <= ?`, guildID, upToID)
+	return err
+}
+
+// Report schedule operations
+
+// GetReportSchedule returns the recap schedule of a guild
+// Guilds that never changed it get DefaultReportSchedule
+func (r *Repository) GetReportSchedule(guildID string) (*ReportSchedule, error) {
+	sched := &ReportSchedule{}
 	err := r.db.QueryRow(
-		`SELECT guild_id, notification_channel_id, created_at FROM guild_settings WHERE guild_id = ?`,
+		`SELECT guild_id, weekday, hour, minute, timezone, weekly, monthly, last_weekly, last_monthly
+		 FROM report_schedules WHERE guild_id = ?`,
 		guildID,
-	).Scan(&settings.GuildID, &settings.NotificationChannelID, &settings.CreatedAt)
+	).Scan(&sched.GuildID, &sched.Weekday, &sched.Hour, &sched.Minute, &sched.Timezone,
+		&sched.Weekly, &sched.Monthly, &sched.LastWeekly, &sched.LastMonthly)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultReportSchedule(guildID), nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	return settings, nil
+	return sched, nil
+}
+
+// UpsertReportSchedule creates or replaces the recap schedule of a guild
+func (r *Repository) UpsertReportSchedule(sched *ReportSchedule) error {
+	_, err := r.db.Exec(
+		`INSERT INTO report_schedules (guild_id, weekday, hour, minute, timezone, weekly, monthly, last_weekly, last_monthly)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(guild_id) DO UPDATE SET weekday = excluded.weekday, hour = excluded.hour, minute = excluded.minute,
+		 timezone = excluded.timezone, weekly = excluded.weekly, monthly = excluded.monthly,
+		 last_weekly = excluded.last_weekly, last_monthly = excluded.last_monthly`,
+		sched.GuildID, sched.Weekday, sched.Hour, sched.Minute, sched.Timezone,
+		sched.Weekly, sched.Monthly, sched.LastWeekly, sched.LastMonthly,
+	)
+	return err
+}
+
+// Notification route operations
+
+// CreateNotificationRoute adds a notification route for a guild
+func (r *Repository) CreateNotificationRoute(route *NotificationRoute) error {
+	result, err := r.db.Exec(
+		`INSERT INTO notification_routes (guild_id, game_type, sink, target, username, avatar_url) VALUES (?, ?, ?, ?, ?, ?)`,
+		route.GuildID, route.GameType, route.Sink, route.Target, route.Username, route.AvatarURL,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	route.ID = id
+	return nil
+}
+
+// GetNotificationRoutes returns all notification routes of a guild
+func (r *Repository) GetNotificationRoutes(guildID string) ([]*NotificationRoute, error) {
+	rows, err := r.db.Query(
+		`SELECT id, guild_id, game_type, sink, target, username, avatar_url, created_at
+		 FROM notification_routes WHERE guild_id = ? ORDER BY id`,
+		guildID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var routes []*NotificationRoute
+	for rows.Next() {
+		route := &NotificationRoute{}
+		if err := rows.Scan(&route.ID, &route.GuildID, &route.GameType, &route.Sink, &route.Target,
+			&route.Username, &route.AvatarURL, &route.CreatedAt); err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+
+	return routes, rows.Err()
+}
+
+// DeleteNotificationRoute removes a route of a guild
+// Returns false if the guild has no route with that ID
+func (r *Repository) DeleteNotificationRoute(guildID string, id int64) (bool, error) {
+	result, err := r.db.Exec(
+		`DELETE FROM notification_routes WHERE guild_id = ? AND id = ?`,
+		guildID, id,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// Match result operations
+
+// InsertMatchResult records a completed match; already recorded matches are ignored
+func (r *Repository) InsertMatchResult(m *MatchResult) error {
+	_, err := r.db.Exec(
+		`INSERT INTO match_results (summoner_id, match_id, win, kills, deaths, assists, character, played_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(summoner_id, match_id) DO NOTHING`,
+		m.SummonerID, m.MatchID, m.Win, m.Kills, m.Deaths, m.Assists, m.Character, m.PlayedAt,
+	)
+	return err
+}
+
+// GetMatchResults returns a summoner's matches played in [from, to), oldest first
+func (r *Repository) GetMatchResults(summonerID int64, from, to time.Time) ([]*MatchResult, error) {
+	rows, err := r.db.Query(
+		`SELECT id, summoner_id, match_id, win, kills, deaths, assists, character, played_at
+		 FROM match_results WHERE summoner_id = ? AND played_at >= ? AND played_at < ?
+		 ORDER BY played_at`,
+		summonerID, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*MatchResult
+	for rows.Next() {
+		m := &MatchResult{}
+		if err := rows.Scan(&m.ID, &m.SummonerID, &m.MatchID, &m.Win, &m.Kills, &m.Deaths,
+			&m.Assists, &m.Character, &m.PlayedAt); err != nil {
+			return nil, err
+		}
+		results = append(results, m)
+	}
+
+	return results, rows.Err()
+}
+
+// GetRecentMatchResults returns a summoner's last n recorded matches, newest first
+func (r *Repository) GetRecentMatchResults(summonerID int64, n int) ([]*MatchResult, error) {
+	rows, err := r.db.Query(
+		`SELECT id, summoner_id, match_id, win, kills, deaths, assists, character, played_at
+		 FROM match_results WHERE summoner_id = ? ORDER BY played_at DESC LIMIT ?`,
+		summonerID, n,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*MatchResult
+	for rows.Next() {
+		m := &MatchResult{}
+		if err := rows.Scan(&m.ID, &m.SummonerID, &m.MatchID, &m.Win, &m.Kills, &m.Deaths,
+			&m.Assists, &m.Character, &m.PlayedAt); err != nil {
+			return nil, err
+		}
+		results = append(results, m)
+	}
+
+	return results, rows.Err()
+}
+
+// Pinned leaderboard operations
+
+// UpsertPinnedLeaderboard records the message showing a guild's leaderboard for a metric
+func (r *Repository) UpsertPinnedLeaderboard(p *PinnedLeaderboard) error {
+	_, err := r.db.Exec(
+		`INSERT INTO pinned_leaderboards (guild_id, metric, games, channel_id, message_id) VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT(guild_id, metric) DO UPDATE SET games = excluded.games,
+		 channel_id = excluded.channel_id, message_id = excluded.message_id`,
+		p.GuildID, p.Metric, p.Games, p.ChannelID, p.MessageID,
+	)
+	return err
+}
+
+// GetPinnedLeaderboard returns a guild's pinned leaderboard for a metric
+// Returns nil without error if there is none
+func (r *Repository) GetPinnedLeaderboard(guildID, metric string) (*PinnedLeaderboard, error) {
+	p := &PinnedLeaderboard{}
+	err := r.db.QueryRow(
+		`SELECT guild_id, metric, games, channel_id, message_id, created_at
+		 FROM pinned_leaderboards WHERE guild_id = ? AND metric = ?`,
+		guildID, metric,
+	).Scan(&p.GuildID, &p.Metric, &p.Games, &p.ChannelID, &p.MessageID, &p.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetAllPinnedLeaderboards returns every pinned leaderboard
+func (r *Repository) GetAllPinnedLeaderboards() ([]*PinnedLeaderboard, error) {
+	rows, err := r.db.Query(
+		`SELECT guild_id, metric, games, channel_id, message_id, created_at FROM pinned_leaderboards`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pinned []*PinnedLeaderboard
+	for rows.Next() {
+		p := &PinnedLeaderboard{}
+		if err := rows.Scan(&p.GuildID, &p.Metric, &p.Games, &p.ChannelID, &p.MessageID, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		pinned = append(pinned, p)
+	}
+
+	return pinned, rows.Err()
+}
+
+// DeletePinnedLeaderboard removes a guild's pinned leaderboard for a metric
+func (r *Repository) DeletePinnedLeaderboard(guildID, metric string) error {
+	_, err := r.db.Exec(
+		`DELETE FROM pinned_leaderboards WHERE guild_id = ? AND metric = ?`,
+		guildID, metric,
+	)
+	return err
+}
+
+// Character snapshot operations
+
+// UpsertCharacterSnapshot creates or replaces the snapshot for a character and day
+func (r *Repository) UpsertCharacterSnapshot(snap *CharacterSnapshot) error {
+	_, err := r.db.Exec(
+		`INSERT INTO character_snapshots (summoner_id, snapshot_date, level, exp, exp_rate) VALUES (?, ?, ?, ?, ?)
+		 ON CONFLICT(summoner_id, snapshot_date) DO UPDATE SET level = excluded.level, exp = excluded.exp, exp_rate = excluded.exp_rate`,
+		snap.SummonerID, snap.Date, snap.Level, snap.Exp, snap.ExpRate,
+	)
+	return err
+}
+
+// GetCharacterSnapshots returns a character's snapshots between two dates (inclusive), oldest first
+func (r *Repository) GetCharacterSnapshots(summonerID int64, from, to string) ([]*CharacterSnapshot, error) {
+	rows, err := r.db.Query(
+		`SELECT id, summoner_id, snapshot_date, level, exp, exp_rate, created_at FROM character_snapshots
+		 WHERE summoner_id = ? AND snapshot_date >= ? AND snapshot_date <= ?
+		 ORDER BY snapshot_date`,
+		summonerID, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snaps []*CharacterSnapshot
+	for rows.Next() {
+		snap := &CharacterSnapshot{}
+		if err := rows.Scan(&snap.ID, &snap.SummonerID, &snap.Date, &snap.Level, &snap.Exp, &snap.ExpRate, &snap.CreatedAt); err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+
+	return snaps, rows.Err()
 }
@@ -0,0 +1,69 @@
+// Package trace tags units of work (an interaction, a poll check) with a
+// short correlation ID that follows them through contexts and logs
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+type contextKey struct{}
+
+// NewID returns a random 8 character correlation ID
+// It doubles as the reference code shown to users, so it stays short
+func NewID() string {
+	b := make([]byte, 4)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithID returns a context carrying a correlation ID
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// Start returns a context carrying a new correlation ID, and the ID
+func Start(ctx context.Context) (context.Context, string) {
+	id := NewID()
+	return WithID(ctx, id), id
+}
+
+// ID returns the correlation ID of a context, or "" if it has none
+func ID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Handler is a slog.Handler that adds the correlation ID of a record's
+// context as a "trace" attribute; log with the *Context slog functions
+type Handler struct {
+	slog.Handler
+}
+
+// NewHandler wraps a handler to add correlation IDs
+func NewHandler(h slog.Handler) *Handler {
+	return &Handler{Handler: h}
+}
+
+// Handle adds the correlation ID and passes the record on
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	if id := ID(ctx); id != "" {
+		r.AddAttrs(slog.String("trace", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs keeps the wrapper around the derived handler
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Handler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup keeps the wrapper around the derived handler
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{Handler: h.Handler.WithGroup(name)}
+}